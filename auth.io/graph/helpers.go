@@ -1,8 +1,12 @@
 package graph
 
 import (
+	"context"
+	"log/slog"
+
 	"auth.io/graph/model"
 	"auth.io/models"
+	"auth.io/realtime"
 )
 
 func assembleUpdateProfile(p model.ProfileInput) *models.UpdateProfile {
@@ -191,3 +195,43 @@ func assembleLocations(l []*models.Location) ([]*model.Location, error) {
 	}
 	return locations, nil
 }
+
+// tagActiveVehicle sends the active vehicle of the driver to the driver
+// index, so the dispatch only offers the driver the orders it can serve.
+func tagActiveVehicle(ctx context.Context, identity models.UserService) {
+	usr, err := identity.Me(ctx)
+	if err != nil || usr.Role != models.RoleDriver {
+		return
+	}
+	v := usr.GetActiveVehicle()
+	if v == nil {
+		return
+	}
+	vehicle := realtime.DriverVehicle{
+		User:     usr.ID,
+		Category: v.Category.String(),
+		Seats:    v.Seats,
+	}
+	for _, f := range v.Facilities {
+		vehicle.Facilities = append(vehicle.Facilities, f.String())
+	}
+	select {
+	case realtime.DriverVehicles <- vehicle:
+	default:
+		slog.Info("unable to tag driver vehicle, the queue is full", "driver", usr.ID)
+	}
+}
+
+// indexAvailability sends the availability of the driver to the driver index,
+// so the drivers going offline stop receiving offers.
+func indexAvailability(ctx context.Context, identity models.UserService, available bool) {
+	usr, err := identity.Me(ctx)
+	if err != nil {
+		return
+	}
+	select {
+	case realtime.UserAvailabilityStatus <- realtime.UserStatus{User: usr.ID, Available: available}:
+	default:
+		slog.Info("unable to index driver availability, the queue is full", "driver", usr.ID)
+	}
+}
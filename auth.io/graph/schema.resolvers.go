@@ -139,7 +139,9 @@ func (r *mutationResolver) UpdateVehicle(ctx context.Context, id string, input m
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Message: err.Error(),
 		})
+		return rsp, nil
 	}
+	tagActiveVehicle(ctx, r.identity)
 	return rsp, nil
 }
 
@@ -269,8 +271,9 @@ func (r *mutationResolver) SetActiveVehicle(ctx context.Context, id string) (*mo
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Message: err.Error(),
 		})
-
+		return rsp, nil
 	}
+	tagActiveVehicle(ctx, r.identity)
 	return rsp, nil
 }
 
@@ -285,7 +288,9 @@ func (r *mutationResolver) SetAvailable(ctx context.Context, available bool) (*m
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Message: err.Error(),
 		})
+		return rsp, nil
 	}
+	indexAvailability(ctx, r.identity, available)
 	return rsp, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/ably/ably-go/ably"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -21,6 +22,7 @@ import (
 	"auth.io/mailer"
 	"auth.io/models"
 	"auth.io/mongo"
+	"auth.io/realtime"
 	rdb "auth.io/redis"
 	"auth.io/seed"
 )
@@ -33,6 +35,7 @@ type App struct {
 	config      Config
 	dialer      *gomail.Dialer
 	client      models.ClientService
+	notifier    realtime.Notifier
 	done        chan struct{}
 	tokenAuth   *jwtauth.JWTAuth
 }
@@ -60,6 +63,12 @@ func New(cfg Config) *App {
 		tokenAuth: jwtauth.New("HS256", []byte(cfg.JWTPrivateKey), nil),
 	}
 
+	rest, err := ably.NewREST(ably.WithKey(cfg.AblyKey))
+	if err != nil {
+		panic(fmt.Sprintf("unable to create the ably client: %v", err))
+	}
+	app.notifier = realtime.NewAblyNotifier(rest)
+
 	app.client = mongo.NewClientService(app.mongo)
 	app.loader()
 	// TODO: check how to load the seeds
@@ -159,6 +168,9 @@ func (a *App) loader() {
 	}))
 
 	userSrv := mongo.NewUserService(a.mongo, a.config.WalletApi, a.done, a.rdb, a.tokenAuth)
+	// the service keeps the driver index up to date and notifies the offers
+	// of the dispatcher to the drivers
+	realtime.NewRealTimeService(realtime.NewDriverIndex(a.redisClient), a.notifier, a.rdb, nil, userSrv)
 
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
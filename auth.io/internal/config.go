@@ -58,6 +58,9 @@ type Config struct {
 	SMTPPassword string
 
 	WalletApi string
+
+	// AblyKey is the API key the notifications are sent to the devices with.
+	AblyKey string
 }
 
 func DefaultConfig() Config {
@@ -161,5 +164,12 @@ func LoadConfig() Config {
 	if cfg.WalletApi == "" {
 		panic("WALLET_API is not set")
 	}
+
+	if ablyKey, exist := os.LookupEnv("ABLY_API_KEY"); exist {
+		cfg.AblyKey = ablyKey
+	}
+	if cfg.AblyKey == "" {
+		panic("ABLY_API_KEY is not set")
+	}
 	return cfg
 }
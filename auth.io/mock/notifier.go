@@ -13,6 +13,7 @@ var _ realtime.Notifier = &Notifier{}
 type Notifier struct {
 	NotifyRiderOrderAcceptedFn func(context.Context, []string, realtime.OrderNotification) error
 	NotifyToDevicesFn          func(context.Context, []string, realtime.OrderNotification, *ably.Realtime, *ably.REST) error
+	NotifyRideReminderFn       func(context.Context, []string, realtime.OrderNotification) error
 }
 
 // NotifyRiderOrderAccepted implements realtime.Notifier.
@@ -24,3 +25,8 @@ func (s Notifier) NotifyRiderOrderAccepted(ctx context.Context, devices []string
 func (s Notifier) NotifyToDevices(ctx context.Context, devices []string, notification realtime.OrderNotification, realTIme *ably.Realtime, rest *ably.REST) error {
 	return s.NotifyToDevicesFn(ctx, devices, notification, realTIme, rest)
 }
+
+// NotifyRideReminder implements realtime.Notifier.
+func (s Notifier) NotifyRideReminder(ctx context.Context, devices []string, notification realtime.OrderNotification) error {
+	return s.NotifyRideReminderFn(ctx, devices, notification)
+}
@@ -0,0 +1,164 @@
+package realtime
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	r "github.com/redis/go-redis/v9"
+
+	"auth.io/models"
+)
+
+var _ FinderUpdater = &DriverIndex{}
+
+// The keys of the driver index, shared with the order.io dispatcher.
+const (
+	driversKey = "drivers"
+	// driversSeenKey scores each driver in the index with its last location
+	// update, so the stale ones are swept.
+	driversSeenKey    = "drivers:seen"
+	driversBusyKey    = "drivers:busy"
+	driversOfflineKey = "drivers:offline"
+)
+
+// driverKey is the hash holding the active vehicle of a driver.
+func driverKey(driver string) string {
+	return "driver:" + driver
+}
+
+// DriverIndex is the geo index of the available drivers.
+type DriverIndex struct {
+	client *r.Client
+}
+
+func NewDriverIndex(client *r.Client) *DriverIndex {
+	return &DriverIndex{client: client}
+}
+
+// FindNearByDrivers implements Finder. Only the drivers whose active vehicle
+// serves the category are returned. An empty category matches any driver,
+// and so do the drivers without a tagged vehicle.
+func (s *DriverIndex) FindNearByDrivers(ctx context.Context, location models.GeoLocation, category string) ([]*models.Location, error) {
+	res, err := s.client.GeoRadius(ctx, driversKey, location.Long, location.Lat, &r.GeoRadiusQuery{
+		Radius:    5,
+		Unit:      "km",
+		WithCoord: true,
+		Count:     20,
+		Sort:      "ASC",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nearby drivers: %v: %w", err, models.ErrInternal)
+	}
+	busy, err := s.client.SMembers(ctx, driversBusyKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get busy drivers: %v: %w", err, models.ErrInternal)
+	}
+	onTrip := make(map[string]bool, len(busy))
+	for _, d := range busy {
+		onTrip[d] = true
+	}
+	var locations []*models.Location
+	for _, l := range res {
+		if onTrip[l.Name] {
+			continue
+		}
+		if category != "" {
+			vehicle, err := s.client.HGetAll(ctx, driverKey(l.Name)).Result()
+			if err != nil {
+				continue
+			}
+			if vehicle["category"] != "" && !serves(vehicle, category) {
+				continue
+			}
+		}
+		locations = append(locations, &models.Location{
+			User: l.Name,
+			Geolocation: models.GeoLocation{
+				Type:        "Point",
+				Coordinates: []float64{l.Longitude, l.Latitude},
+				Lat:         l.Latitude,
+				Long:        l.Longitude,
+			},
+		})
+	}
+	return locations, nil
+}
+
+// xlSeats is the number of seats a vehicle needs to serve XL orders.
+const xlSeats = 6
+
+// serves reports whether the vehicle tagged in the index can serve orders of
+// the category, as the order.io dispatcher matches them. Any passenger
+// vehicle serves X orders and the ones with enough seats serve XL orders too.
+func serves(vehicle map[string]string, category string) bool {
+	switch {
+	case vehicle["category"] == category:
+		return true
+	case vehicle["category"] == "Package":
+		return false
+	case category == "X":
+		return true
+	case category == "XL":
+		seats, _ := strconv.Atoi(vehicle["seats"])
+		return seats >= xlSeats
+	}
+	return false
+}
+
+// UpdateLocation implements Updater. The heartbeat is recorded with
+// the position, so drivers that stop sending locations are swept from the
+// index. Locations of offline drivers are ignored.
+func (s *DriverIndex) UpdateLocation(ctx context.Context, driver string, location models.GeoLocation) error {
+	offline, err := s.client.SIsMember(ctx, driversOfflineKey, driver).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get driver availability: %v: %w", err, models.ErrInternal)
+	}
+	if offline {
+		return nil
+	}
+	pipe := s.client.TxPipeline()
+	pipe.GeoAdd(ctx, driversKey, &r.GeoLocation{
+		Name:      driver,
+		Longitude: location.Long,
+		Latitude:  location.Lat,
+	})
+	pipe.ZAdd(ctx, driversSeenKey, r.Z{Score: float64(time.Now().Unix()), Member: driver})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update driver location: %v: %w", err, models.ErrInternal)
+	}
+	return nil
+}
+
+// SetVehicle implements VehicleUpdater.
+func (s *DriverIndex) SetVehicle(ctx context.Context, vehicle DriverVehicle) error {
+	err := s.client.HSet(ctx, driverKey(vehicle.User),
+		"category", vehicle.Category,
+		"seats", vehicle.Seats,
+		"facilities", strings.Join(vehicle.Facilities, ","),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set driver vehicle: %v: %w", err, models.ErrInternal)
+	}
+	return nil
+}
+
+// SetAvailable implements AvailabilityUpdater. Drivers going
+// offline leave the index, available ones are added back with their next
+// location.
+func (s *DriverIndex) SetAvailable(ctx context.Context, driver string, available bool) error {
+	pipe := s.client.TxPipeline()
+	if available {
+		pipe.SRem(ctx, driversOfflineKey, driver)
+	} else {
+		pipe.SAdd(ctx, driversOfflineKey, driver)
+		pipe.ZRem(ctx, driversKey, driver)
+		pipe.ZRem(ctx, driversSeenKey, driver)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set driver availability: %v: %w", err, models.ErrInternal)
+	}
+	return nil
+}
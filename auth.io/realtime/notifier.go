@@ -0,0 +1,76 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ably/ably-go/ably"
+)
+
+var _ Notifier = &AblyNotifier{}
+
+// The events published to the devices.
+const (
+	EventOrderOffered  = "order:offered"
+	EventOrderAccepted = "order:accepted"
+	EventRideReminder  = "ride:reminder"
+)
+
+// deviceChannel is the Ably channel a device listens to.
+func deviceChannel(device string) string {
+	return "device:" + device
+}
+
+// AblyNotifier sends the notifications to the channels of the devices on
+// Ably.
+type AblyNotifier struct {
+	rest *ably.REST
+}
+
+func NewAblyNotifier(rest *ably.REST) *AblyNotifier {
+	return &AblyNotifier{rest: rest}
+}
+
+// NotifyToDevices implements Notifier. The order is published with the given
+// clients when set, the realtime connection first.
+func (n *AblyNotifier) NotifyToDevices(ctx context.Context, devices []string, notification OrderNotification, realTime *ably.Realtime, rest *ably.REST) error {
+	if realTime != nil {
+		return publish(devices, func(device string) error {
+			return realTime.Channels.Get(deviceChannel(device)).Publish(ctx, EventOrderOffered, notification)
+		})
+	}
+	if rest == nil {
+		rest = n.rest
+	}
+	return n.publishREST(ctx, rest, devices, EventOrderOffered, notification)
+}
+
+// NotifyRiderOrderAccepted implements Notifier.
+func (n *AblyNotifier) NotifyRiderOrderAccepted(ctx context.Context, devices []string, notification OrderNotification) error {
+	return n.publishREST(ctx, n.rest, devices, EventOrderAccepted, notification)
+}
+
+// NotifyRideReminder implements Notifier. The riders are reminded of their
+// scheduled rides due soon.
+func (n *AblyNotifier) NotifyRideReminder(ctx context.Context, devices []string, notification OrderNotification) error {
+	return n.publishREST(ctx, n.rest, devices, EventRideReminder, notification)
+}
+
+func (n *AblyNotifier) publishREST(ctx context.Context, rest *ably.REST, devices []string, event string, notification OrderNotification) error {
+	return publish(devices, func(device string) error {
+		return rest.Channels.Get(deviceChannel(device)).Publish(ctx, event, notification)
+	})
+}
+
+// publish sends the notification to every device, so a failing device does
+// not keep the others from being notified.
+func publish(devices []string, send func(string) error) error {
+	var errs []error
+	for _, device := range devices {
+		if err := send(device); err != nil {
+			errs = append(errs, fmt.Errorf("unable to notify device %s: %w", device, err))
+		}
+	}
+	return errors.Join(errs...)
+}
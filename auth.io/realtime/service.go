@@ -16,6 +16,7 @@ import (
 var (
 	DriverLocations        = make(chan models.Location, 10000)
 	UserAvailabilityStatus = make(chan UserStatus, 1000)
+	DriverVehicles         = make(chan DriverVehicle, 1000)
 )
 
 type UserStatus struct {
@@ -23,17 +24,55 @@ type UserStatus struct {
 	Available bool
 }
 
+// DriverVehicle is the active vehicle a driver is tagged with in the driver
+// index, so nearby driver queries only return drivers eligible for an order.
+type DriverVehicle struct {
+	User       string   `json:"user"`
+	Category   string   `json:"category"`
+	Seats      int      `json:"seats"`
+	Facilities []string `json:"facilities,omitempty"`
+}
+
+// orderCategories maps the vehicle categories to the order categories they
+// are tagged with.
+var orderCategories = map[string]string{
+	"ECONOMY":   "X",
+	"LUXURY":    "Confort",
+	"PREMIUM":   "Confort",
+	"PETS":      "Pets",
+	"PACKAGE":   "Package",
+	"PREIORITY": "Priority",
+}
+
 type Finder interface {
-	FindNearByDrivers(context.Context, models.GeoLocation) ([]*models.Location, error)
+	FindNearByDrivers(context.Context, models.GeoLocation, string) ([]*models.Location, error)
 }
 
+// Updater stores the positions of the drivers in the driver index. The
+// heartbeat of the driver is recorded with every position, so the order.io
+// sweeper removes the drivers that stop sending them.
 type Updater interface {
 	UpdateLocation(context.Context, string, models.GeoLocation) error
 }
 
+// VehicleUpdater tags the drivers in the driver index with their active
+// vehicle. It matches the SetVehicle of the order.io driver index, which
+// reads the tags when dispatching.
+type VehicleUpdater interface {
+	SetVehicle(context.Context, DriverVehicle) error
+}
+
+// AvailabilityUpdater removes the drivers going offline from the driver
+// index, so they are not offered orders until they send a location again.
+type AvailabilityUpdater interface {
+	SetAvailable(context.Context, string, bool) error
+}
+
 type FinderUpdater interface {
 	Finder
 	Updater
+	VehicleUpdater
+	AvailabilityUpdater
 }
 type OrderNotification struct {
 	ID       string `json:"id"`
@@ -56,6 +95,7 @@ func AssambleOrderNotification(order *models.Order) OrderNotification {
 type Notifier interface {
 	NotifyToDevices(context.Context, []string, OrderNotification, *ably.Realtime, *ably.REST) error
 	NotifyRiderOrderAccepted(context.Context, []string, OrderNotification) error
+	NotifyRideReminder(context.Context, []string, OrderNotification) error
 }
 
 type UserUpdateService interface {
@@ -87,15 +127,15 @@ func NewRealTimeService(
 		user:         user,
 	}
 
-	go storeOrUpdateDriversLocation(finder)
+	RunDriverIndex(finder)
 	go processNewOrder(s)
-	go updateUserStatus(user)
 	go notifyDrivers(s)
+	go remindRiders(s)
 
 	return s
 }
-func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location models.GeoLocation) ([]*models.Location, error) {
-	locations, err := s.finder.FindNearByDrivers(ctx, location)
+func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location models.GeoLocation, category string) ([]*models.Location, error) {
+	locations, err := s.finder.FindNearByDrivers(ctx, location, category)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +146,14 @@ func (s *RealTimeService) NotifyToDevices(ctx context.Context, users []string, o
 	return s.notifier.NotifyToDevices(ctx, users, order, realTime, rest)
 }
 
+// RunDriverIndex keeps the driver index up to date with the locations,
+// vehicles and availability of the drivers sent on the channels.
+func RunDriverIndex(index FinderUpdater) {
+	go storeOrUpdateDriversLocation(index)
+	go storeDriversVehicle(index)
+	go updateUserStatus(index)
+}
+
 func storeOrUpdateDriversLocation(s Updater) {
 	ctx := context.Background()
 	for v := range DriverLocations {
@@ -116,29 +164,51 @@ func storeOrUpdateDriversLocation(s Updater) {
 	}
 }
 
+func storeDriversVehicle(s VehicleUpdater) {
+	ctx := context.Background()
+	for v := range DriverVehicles {
+		category, ok := orderCategories[v.Category]
+		if !ok {
+			slog.Info("unknown vehicle category", "category", v.Category)
+			continue
+		}
+		v.Category = category
+		if err := s.SetVehicle(ctx, v); err != nil {
+			slog.Info("unable to update driver vehicle")
+		}
+	}
+}
+
 func processNewOrder(s *RealTimeService) {
 
 }
 
-func updateUserStatus(service models.UserService) {
-	ctx := adminContext()
+// updateUserStatus applies the availability the drivers set to the driver
+// index. The availability of the user is already stored by the mutation.
+func updateUserStatus(index AvailabilityUpdater) {
+	ctx := context.Background()
 	for v := range UserAvailabilityStatus {
-		usr, err := service.FindByID(ctx, v.User)
-		if err != nil {
-			slog.Info("unable to find user")
-			continue
-		}
-		usr.Available = v.Available
-
-		if err := service.Update(ctx, usr); err != nil {
-			slog.Info("unable to update use availability")
+		if err := index.SetAvailable(ctx, v.User, v.Available); err != nil {
+			slog.Info("unable to update driver availability in the index")
 		}
 	}
 }
 
+// orderOffers is published by the order dispatcher when an order is offered
+// to some drivers.
+type orderOffers struct {
+	Order  models.Order `json:"order"`
+	Offers []struct {
+		Driver    string `json:"driver"`
+		ExpiresAt int64  `json:"expires_at"`
+	} `json:"offers"`
+}
+
+// notifyDrivers sends the order offers made by the dispatcher to the devices
+// of the offered drivers.
 func notifyDrivers(s *RealTimeService) {
 	ctx := adminContext()
-	pubsub := s.redis.Subscripe(ctx, "orders")
+	pubsub := s.redis.Subscripe(ctx, "order:offers")
 	defer pubsub.Close()
 	for {
 		msg, err := pubsub.ReceiveMessage(ctx)
@@ -147,29 +217,18 @@ func notifyDrivers(s *RealTimeService) {
 			continue
 		}
 
-		var order models.Order
-		if err := json.Unmarshal([]byte(msg.Payload), &order); err != nil {
-			slog.Info("unable to get order")
+		var offers orderOffers
+		if err := json.Unmarshal([]byte(msg.Payload), &offers); err != nil {
+			slog.Info("unable to get order offers")
 			continue
 		}
-
-		startPoint := models.GeoLocation{
-			Type:        "Point",
-			Coordinates: []float64{order.Item.Points[0].Lng, order.Item.Points[0].Lat},
-			Lat:         order.Item.Points[0].Lat,
-			Long:        order.Item.Points[0].Lng,
+		var users []string
+		for _, o := range offers.Offers {
+			users = append(users, o.Driver)
 		}
-		locations, err := s.finder.FindNearByDrivers(ctx, startPoint)
-		if err != nil || len(locations) == 0 {
-			slog.Info("unable to get drivers")
+		if len(users) == 0 {
 			continue
 		}
-		var users []string
-		for _, l := range locations {
-			if _, ok := order.BannedDrivers[l.User]; !ok {
-				users = append(users, l.User)
-			}
-		}
 		devices, err := s.user.GetUserDevices(ctx, models.UserFilter{
 			Ids:  users,
 			Role: models.RoleDriver,
@@ -178,13 +237,45 @@ func notifyDrivers(s *RealTimeService) {
 			slog.Info("unable to get devices")
 			continue
 		}
-		if err := s.notifier.NotifyToDevices(ctx, devices, AssambleOrderNotification(&order), s.ablyRealTime, s.rest); err != nil {
+		if err := s.notifier.NotifyToDevices(ctx, devices, AssambleOrderNotification(&offers.Order), s.ablyRealTime, s.rest); err != nil {
 			slog.Info("unable to notify drivers")
 			continue
 		}
 	}
 }
 
+// remindRiders notifies the riders of the scheduled rides due soon.
+func remindRiders(s *RealTimeService) {
+	ctx := adminContext()
+	pubsub := s.redis.Subscripe(ctx, "order:reminder")
+	defer pubsub.Close()
+	for {
+		msg, err := pubsub.ReceiveMessage(ctx)
+		if err != nil {
+			slog.Info("unable to receive message", "%v", err)
+			continue
+		}
+
+		var order models.Order
+		if err := json.Unmarshal([]byte(msg.Payload), &order); err != nil {
+			slog.Info("unable to get order")
+			continue
+		}
+		devices, err := s.user.GetUserDevices(ctx, models.UserFilter{
+			Ids:  []string{order.Rider},
+			Role: models.RoleRider,
+		})
+		if err != nil || len(devices) == 0 {
+			slog.Info("unable to get devices")
+			continue
+		}
+		if err := s.notifier.NotifyRideReminder(ctx, devices, AssambleOrderNotification(&order)); err != nil {
+			slog.Info("unable to notify rider")
+			continue
+		}
+	}
+}
+
 func adminContext() context.Context {
 	ctx := context.Background()
 	token := jwt.New()
@@ -9,9 +9,13 @@ import (
 
 func NewHandler(
 	order order.OrderService,
+	driver order.DriverService,
+	surge order.SurgeService,
 ) *handler.Server {
 	resolver := &Resolver{
-		order: order,
+		order:  order,
+		driver: driver,
+		surge:  surge,
 	}
 	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
 	srv.AddTransport(&transport.Websocket{})
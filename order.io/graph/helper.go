@@ -1,6 +1,10 @@
 package graph
 
 import (
+	"context"
+	"log/slog"
+	"time"
+
 	"order.io/graph/model"
 	"order.io/pkg/order"
 )
@@ -21,9 +25,43 @@ func assembleOrderItem(input *model.RideInput) order.Item {
 	if input.Baggages != nil {
 		item.Baggages = *input.Baggages
 	}
+	if input.ScheduledAt != nil {
+		item.ScheduledAt = int64(*input.ScheduledAt)
+	}
+	if input.Delivery != nil {
+		item.Delivery = assembleDelivery(input.Delivery)
+	}
 	return item
 }
 
+func assembleDelivery(input *model.DeliveryInput) *order.Delivery {
+	d := &order.Delivery{
+		RecipientName:  input.RecipientName,
+		RecipientPhone: input.RecipientPhone,
+		Size:           order.PackageSize(input.Size),
+		Weight:         input.Weight,
+	}
+	if input.Instructions != nil {
+		d.Instructions = *input.Instructions
+	}
+	return d
+}
+
+func assembleDeliveryProof(input model.DeliveryProofInput) (order.DeliveryProof, string) {
+	var proof order.DeliveryProof
+	if input.Signature != nil {
+		proof.Signature = *input.Signature
+	}
+	if input.Photo != nil {
+		proof.Photo = *input.Photo
+	}
+	var otp string
+	if input.Otp != nil {
+		otp = *input.Otp
+	}
+	return proof, otp
+}
+
 func assemblePoint(input *model.PointInput) order.Point {
 	return order.Point{
 		Lat: input.Lat,
@@ -40,6 +78,185 @@ func assemblePoints(input []*model.PointInput) []*order.Point {
 	return points
 }
 
+func assembleTrackPoints(input []*model.TrackPointInput) []*order.Point {
+	points := make([]*order.Point, len(input))
+	for i, p := range input {
+		points[i] = &order.Point{
+			Lat:       p.Lat,
+			Lng:       p.Lng,
+			Timestamp: int64(p.Timestamp),
+		}
+	}
+	return points
+}
+
+// assembleModelOrders maps a stream of orders to the GraphQL model, closing
+// the returned channel once the stream ends.
+func assembleModelOrders(ctx context.Context, orders <-chan *order.Order) <-chan *model.Order {
+	ch := make(chan *model.Order, 1)
+	go func() {
+		defer close(ch)
+		for o := range orders {
+			m, err := assembleModelOrder(o)
+			if err != nil {
+				slog.Info("unable to assemble order update", "order", o.ID, "error", err)
+				continue
+			}
+			select {
+			case ch <- m:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func assembleModelStops(stops []*order.Stop) []*model.Stop {
+	if len(stops) == 0 {
+		return nil
+	}
+	items := make([]*model.Stop, len(stops))
+	for i, s := range stops {
+		item := &model.Stop{
+			ID:       s.ID,
+			Point:    assembleModelPoint(s.Point),
+			Status:   model.StopStatus(s.Status),
+			Distance: s.Distance,
+			Duration: s.Duration,
+		}
+		if s.ArrivedAt > 0 {
+			arrivedAt := time.Unix(s.ArrivedAt, 0).UTC().Format(time.RFC3339)
+			item.ArrivedAt = &arrivedAt
+		}
+		if s.DepartedAt > 0 {
+			departedAt := time.Unix(s.DepartedAt, 0).UTC().Format(time.RFC3339)
+			item.DepartedAt = &departedAt
+		}
+		items[i] = item
+	}
+	return items
+}
+
+func assembleModelDelivery(d *order.Delivery) *model.Delivery {
+	if d == nil {
+		return nil
+	}
+	item := &model.Delivery{
+		RecipientName:  d.RecipientName,
+		RecipientPhone: d.RecipientPhone,
+		Size:           model.PackageSize(d.Size),
+		Weight:         d.Weight,
+	}
+	if d.Instructions != "" {
+		item.Instructions = &d.Instructions
+	}
+	if d.TrackingToken != "" {
+		item.TrackingToken = &d.TrackingToken
+	}
+	if d.Proof != nil {
+		item.OtpVerified = d.Proof.OTPVerified
+		if d.Proof.Signature != "" {
+			item.Signature = &d.Proof.Signature
+		}
+		if d.Proof.Photo != "" {
+			item.Photo = &d.Proof.Photo
+		}
+		deliveredAt := time.Unix(d.Proof.DeliveredAt, 0).UTC().Format(time.RFC3339)
+		item.DeliveredAt = &deliveredAt
+	}
+	return item
+}
+
+func assembleModelDeliveryTracking(t *order.DeliveryTracking) (*model.DeliveryTracking, error) {
+	status, err := assembleModelOrderStatus(t.Status)
+	if err != nil {
+		return nil, err
+	}
+	item := &model.DeliveryTracking{
+		Order:         t.Order,
+		Status:        status,
+		RecipientName: t.RecipientName,
+	}
+	if t.OTP != "" {
+		item.Otp = &t.OTP
+	}
+	if t.Dropoff != nil {
+		item.Dropoff = assembleModelPoint(t.Dropoff)
+	}
+	if t.DriverPosition != nil {
+		item.DriverPosition = assembleModelDriverPosition(t.DriverPosition)
+	}
+	if t.DeliveredAt > 0 {
+		deliveredAt := time.Unix(t.DeliveredAt, 0).UTC().Format(time.RFC3339)
+		item.DeliveredAt = &deliveredAt
+	}
+	return item, nil
+}
+
+func assembleModelSurge(s *order.Surge) *model.Surge {
+	item := &model.Surge{
+		Cell:       s.Cell,
+		Multiplier: s.Multiplier,
+		Demand:     s.Demand,
+		Supply:     s.Supply,
+		Override:   s.Override,
+		UpdatedAt:  time.Unix(s.UpdatedAt, 0).UTC().Format(time.RFC3339),
+	}
+	if s.ExpiresAt > 0 {
+		expiresAt := time.Unix(s.ExpiresAt, 0).UTC().Format(time.RFC3339)
+		item.ExpiresAt = &expiresAt
+	}
+	return item
+}
+
+func assembleModelOnlineDrivers(drivers []*order.OnlineDriver) []*model.OnlineDriver {
+	items := make([]*model.OnlineDriver, len(drivers))
+	for i, d := range drivers {
+		item := &model.OnlineDriver{
+			Driver:   d.Driver,
+			Location: assembleModelPoint(d.Location),
+			LastSeen: time.Unix(d.LastSeen, 0).UTC().Format(time.RFC3339),
+			OnTrip:   d.OnTrip,
+		}
+		if d.Vehicle != nil {
+			category := model.Category(d.Vehicle.Category)
+			if category.IsValid() {
+				item.Category = &category
+			}
+			item.Seats = &d.Vehicle.Seats
+		}
+		items[i] = item
+	}
+	return items
+}
+
+func assembleModelDriverPositions(ctx context.Context, positions <-chan *order.DriverPosition) <-chan *model.DriverPosition {
+	ch := make(chan *model.DriverPosition, 1)
+	go func() {
+		defer close(ch)
+		for p := range positions {
+			select {
+			case ch <- assembleModelDriverPosition(p):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func assembleModelDriverPosition(p *order.DriverPosition) *model.DriverPosition {
+	return &model.DriverPosition{
+		Order:     p.Order,
+		Driver:    p.Driver,
+		Location:  assembleModelPoint(p.Location),
+		Distance:  p.Distance,
+		Eta:       int(p.ETA),
+		UpdatedAt: time.Unix(p.UpdatedAt, 0).UTC().Format(time.RFC3339),
+	}
+}
+
 func assembleModelOrder(o *order.Order) (*model.Order, error) {
 	ord := &model.Order{
 		ID:       o.ID,
@@ -53,11 +270,55 @@ func assembleModelOrder(o *order.Order) (*model.Order, error) {
 		ChargeID: &o.ChargeID,
 		History:  assembleModelPoints(o.History),
 	}
-	status := model.OrderStatus(o.Status)
-	if !status.IsValid() {
-		return nil, order.NewInvalidParameter("status", "invalid status")
+	if o.CancellationFee > 0 {
+		ord.CancellationFee = &o.CancellationFee
+	}
+	if o.QuotedPrice > 0 {
+		ord.QuotedPrice = &o.QuotedPrice
+	}
+	if o.FinalPrice > 0 {
+		ord.FinalPrice = &o.FinalPrice
+	}
+	if o.Coupon != "" {
+		ord.Coupon = &o.Coupon
+	}
+	if o.Discount > 0 {
+		ord.Discount = &o.Discount
+	}
+	if o.SurgeMultiplier > 0 {
+		ord.SurgeMultiplier = &o.SurgeMultiplier
+	}
+	if o.Zone != "" {
+		ord.Zone = &o.Zone
+	}
+	if o.Surcharge > 0 {
+		ord.Surcharge = &o.Surcharge
+	}
+	if o.ChargeStatus != "" {
+		chargeStatus := model.ChargeStatus(o.ChargeStatus)
+		ord.ChargeStatus = &chargeStatus
+	}
+	if o.SelectedCategory != nil {
+		ord.FareBreakdown = assembleModelFareBreakdown(o.SelectedCategory.Breakdown)
+	}
+	ord.Stops = assembleModelStops(o.Stops)
+	ord.Delivery = assembleModelDelivery(o.Delivery)
+	if o.ScheduledAt > 0 {
+		scheduledAt := time.Unix(o.ScheduledAt, 0).UTC().Format(time.RFC3339)
+		ord.ScheduledAt = &scheduledAt
+	}
+	if o.ArrivedAt > 0 {
+		arrivedAt := time.Unix(o.ArrivedAt, 0).UTC().Format(time.RFC3339)
+		ord.ArrivedAt = &arrivedAt
+		ord.WaitMinutes = &o.WaitMinutes
+		ord.WaitCharge = &o.WaitCharge
+	}
+	status, err := assembleModelOrderStatus(o.Status)
+	if err != nil {
+		return nil, err
 	}
 	ord.Status = &status
+	ord.StatusHistory = assembleModelStatusHistories(o.StatusHistory)
 	if o.ChargeMethod != "" {
 		pm := model.PaymentMethod(o.ChargeMethod)
 		if !pm.IsValid() {
@@ -69,6 +330,72 @@ func assembleModelOrder(o *order.Order) (*model.Order, error) {
 	return ord, nil
 }
 
+// orderStatuses maps the order status stored by the service to the one
+// exposed over the API.
+var orderStatuses = map[order.OrderStatus]model.OrderStatus{
+	order.OrderStatusNew:           model.OrderStatusNew,
+	order.OrderStatusConfirmed:     model.OrderStatusPending,
+	order.OrderStatusWaitingDriver: model.OrderStatusWaitingDriver,
+	order.OrderStatusOnTheWay:      model.OrderStatusAccepted,
+	order.OrderStatusArrived:       model.OrderStatusDriverArrived,
+	order.OrderStatusPickUp:        model.OrderStatusPickedUp,
+	order.OrderStatusDropOff:       model.OrderStatusDelivered,
+	order.OrderStatusCancel:        model.OrderStatusCancelled,
+	order.OrderStatusNoDriver:      model.OrderStatusNoDriverFound,
+	order.OrderStatusScheduled:     model.OrderStatusScheduled,
+}
+
+func assembleModelOrderStatus(status order.OrderStatus) (model.OrderStatus, error) {
+	s, ok := orderStatuses[status]
+	if !ok {
+		return "", order.NewInvalidParameter("status", "invalid status")
+	}
+	return s, nil
+}
+
+func assembleOrderStatus(status model.OrderStatus) order.OrderStatus {
+	for s, m := range orderStatuses {
+		if m == status {
+			return s
+		}
+	}
+	return ""
+}
+
+func assembleModelStatusHistory(h *order.OrderStatusHistory) *model.OrderStatusHistory {
+	status, _ := assembleModelOrderStatus(h.Status)
+	history := &model.OrderStatusHistory{
+		ID:        h.ID,
+		Status:    status,
+		Timestamp: h.ChangedAt,
+	}
+	if previous, err := assembleModelOrderStatus(h.Previous); err == nil {
+		history.PreviousStatus = &previous
+	}
+	if h.Actor != "" {
+		history.Actor = &h.Actor
+	}
+	if h.ActorRole != "" {
+		role := model.Role(h.ActorRole)
+		history.ActorRole = &role
+	}
+	if h.Reason != "" {
+		history.Reason = &h.Reason
+	}
+	if h.Location != nil {
+		history.Location = assembleModelPoint(h.Location)
+	}
+	return history
+}
+
+func assembleModelStatusHistories(history []*order.OrderStatusHistory) []*model.OrderStatusHistory {
+	items := make([]*model.OrderStatusHistory, len(history))
+	for i, h := range history {
+		items[i] = assembleModelStatusHistory(h)
+	}
+	return items
+}
+
 func assembleModelItem(item order.Item) *model.Item {
 	return &model.Item{
 		Points:   assembleModelPoints(item.Points),
@@ -80,10 +407,15 @@ func assembleModelItem(item order.Item) *model.Item {
 }
 
 func assembleModelPoint(point *order.Point) *model.Point {
-	return &model.Point{
+	p := &model.Point{
 		Lat: point.Lat,
 		Lng: point.Lng,
 	}
+	if point.Timestamp > 0 {
+		timestamp := int(point.Timestamp)
+		p.Timestamp = &timestamp
+	}
+	return p
 }
 
 func assembleModelPoints(points []*order.Point) []*model.Point {
@@ -102,10 +434,7 @@ func assembleOrderFilter(filter model.OrderListFilter) order.OrderFilter {
 		f.Limit = *filter.Limit
 	}
 	if filter.Status != nil {
-		f.Status = order.OrderStatus(*filter.Status)
-		if !f.Status.IsValid() {
-			f.Status = ""
-		}
+		f.Status = assembleOrderStatus(*filter.Status)
 	}
 	if filter.Token != nil {
 		f.Token = *filter.Token
@@ -116,7 +445,7 @@ func assembleOrderFilter(filter model.OrderListFilter) order.OrderFilter {
 
 func assembleCategoryPrice(category order.VehicleCategory, price int, currency string) (*model.CategoryPrice, error) {
 	catPrice := &model.CategoryPrice{
-		Price:    float64(price),
+		Price:    price,
 		Currency: currency,
 	}
 	catPrice.Category = model.Category(category)
@@ -131,7 +460,34 @@ func assembleCategoryPrices(categories []*order.CategoryPrice) []*model.Category
 	catPrices := make([]*model.CategoryPrice, len(categories))
 	for i, c := range categories {
 		catPrice, _ := assembleCategoryPrice(c.Category, c.Price, c.Currency)
+		if catPrice != nil && c.Discount > 0 {
+			catPrice.Discount = &c.Discount
+		}
+		if catPrice != nil {
+			catPrice.Breakdown = assembleModelFareBreakdown(c.Breakdown)
+		}
 		catPrices[i] = catPrice
 	}
 	return catPrices
 }
+
+func assembleModelFareBreakdown(b *order.FareBreakdown) *model.FareBreakdown {
+	if b == nil {
+		return nil
+	}
+	return &model.FareBreakdown{
+		Base:       b.Base,
+		Distance:   b.Distance,
+		Time:       b.Time,
+		Passengers: b.Passengers,
+		Baggage:    b.Baggage,
+		Pet:        b.Pet,
+		Surge:      b.Surge,
+		Surcharge:  b.Surcharge,
+		Discount:   b.Discount,
+		TaxRate:    b.TaxRate,
+		Tax:        b.Tax,
+		Rounding:   b.Rounding,
+		Total:      b.Total(),
+	}
+}
@@ -13,9 +13,13 @@ type CategoryPrice struct {
 	// Category selected by the rider
 	Category Category `json:"category"`
 	// Price of the category
-	Price float64 `json:"price"`
+	Price int `json:"price"`
 	// Currency of the price
 	Currency string `json:"currency"`
+	// Discount of the coupon already taken off the price
+	Discount *int `json:"discount,omitempty"`
+	// Lines of the price
+	Breakdown *FareBreakdown `json:"breakdown,omitempty"`
 }
 
 // Input to confirm the ride and select the vategory and payment method
@@ -28,11 +32,104 @@ type ConfirmRideInput struct {
 	Method PaymentMethod `json:"method"`
 }
 
+type Delivery struct {
+	RecipientName  string      `json:"recipient_name"`
+	RecipientPhone string      `json:"recipient_phone"`
+	Size           PackageSize `json:"size"`
+	// Weight of the package in kg
+	Weight       float64 `json:"weight"`
+	Instructions *string `json:"instructions,omitempty"`
+	// Token of the tracking link shared with the recipient. Only shown to the rider
+	TrackingToken *string `json:"tracking_token,omitempty"`
+	// The recipient gave the delivery code to the driver
+	OtpVerified bool    `json:"otp_verified"`
+	Signature   *string `json:"signature,omitempty"`
+	Photo       *string `json:"photo,omitempty"`
+	DeliveredAt *string `json:"delivered_at,omitempty"`
+}
+
+// Package and recipient of a delivery order
+type DeliveryInput struct {
+	// Name of the recipient
+	RecipientName string `json:"recipientName"`
+	// Phone of the recipient
+	RecipientPhone string `json:"recipientPhone"`
+	// Size of the package
+	Size PackageSize `json:"size"`
+	// Weight of the package in kg
+	Weight float64 `json:"weight"`
+	// Instructions for the driver at drop off
+	Instructions *string `json:"instructions,omitempty"`
+}
+
+// Proof of delivery captured by the driver at drop off. Either the recipient code, a signature or a photo is required
+type DeliveryProofInput struct {
+	// Code given by the recipient
+	Otp *string `json:"otp,omitempty"`
+	// URL of the recipient signature
+	Signature *string `json:"signature,omitempty"`
+	// URL of the photo of the delivered package
+	Photo *string `json:"photo,omitempty"`
+}
+
+type DeliveryTracking struct {
+	Order         string      `json:"order"`
+	Status        OrderStatus `json:"status"`
+	RecipientName string      `json:"recipient_name"`
+	// Code to give to the driver at drop off, shown until the package is delivered
+	Otp     *string `json:"otp,omitempty"`
+	Dropoff *Point  `json:"dropoff,omitempty"`
+	// Position of the driver while the delivery is on its way
+	DriverPosition *DriverPosition `json:"driver_position,omitempty"`
+	DeliveredAt    *string         `json:"delivered_at,omitempty"`
+}
+
+type DriverPosition struct {
+	Order    string `json:"order"`
+	Driver   string `json:"driver"`
+	Location *Point `json:"location"`
+	// Distance to the pickup point in meters
+	Distance float64 `json:"distance"`
+	// Estimated seconds for the driver to reach the pickup point. Zero once the driver arrived
+	Eta       int    `json:"eta"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 type Error struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
 }
 
+// Fare breakdown. The lines add up to the price, the tax is included in it
+type FareBreakdown struct {
+	// Base price of the rate
+	Base int `json:"base"`
+	// Price of the distance
+	Distance int `json:"distance"`
+	// Price of the duration
+	Time int `json:"time"`
+	// Price of the passengers
+	Passengers int `json:"passengers"`
+	// Price of the baggage
+	Baggage int `json:"baggage"`
+	// Price of the pet
+	Pet int `json:"pet"`
+	// Amount added by the surge multiplier
+	Surge int `json:"surge"`
+	// Pickup and drop off surcharges of the zones
+	Surcharge int `json:"surcharge"`
+	// Discount of the coupon taken off the price
+	Discount int `json:"discount"`
+	// Percentage of tax included in the price
+	TaxRate float64 `json:"tax_rate"`
+	// Tax included in the price
+	Tax int `json:"tax"`
+	// Difference between the lines and the price due to rounding
+	Rounding int `json:"rounding"`
+	// Price after the discount
+	Total int `json:"total"`
+}
+
 // Item information used to request a ride
 type Item struct {
 	// List of the points for the route
@@ -50,6 +147,18 @@ type Item struct {
 type Mutation struct {
 }
 
+type OnlineDriver struct {
+	Driver   string `json:"driver"`
+	Location *Point `json:"location"`
+	// Last location update
+	LastSeen string `json:"last_seen"`
+	// The driver is on an active trip and is not matched with other orders
+	OnTrip bool `json:"on_trip"`
+	// Category of the active vehicle of the driver
+	Category *Category `json:"category,omitempty"`
+	Seats    *int      `json:"seats,omitempty"`
+}
+
 // Order information. Contain all the information about the order.
 type Order struct {
 	// Unique identifier
@@ -86,6 +195,38 @@ type Order struct {
 	ChargeID *string `json:"charge_id,omitempty"`
 	// Payment charge id
 	Category *Category `json:"category,omitempty"`
+	// Fee charged to the rider for canceling the ride
+	CancellationFee *int `json:"cancellation_fee,omitempty"`
+	// Intermediate stops between the pickup and the drop off
+	Stops []*Stop `json:"stops,omitempty"`
+	// Requested pickup time of a ride booked in advance
+	ScheduledAt *string `json:"scheduled_at,omitempty"`
+	// Package of a delivery order
+	Delivery *Delivery `json:"delivery,omitempty"`
+	// Time when the driver arrived to the pickup point
+	ArrivedAt *string `json:"arrived_at,omitempty"`
+	// Billed waiting minutes beyond the free allowance
+	WaitMinutes *int `json:"wait_minutes,omitempty"`
+	// Price of the billed waiting minutes, included in the order price
+	WaitCharge *int `json:"wait_charge,omitempty"`
+	// Price quoted to the rider when the ride was confirmed
+	QuotedPrice *int `json:"quoted_price,omitempty"`
+	// Price computed from the actual trip when the ride finished
+	FinalPrice *int `json:"final_price,omitempty"`
+	// Coupon applied to the ride
+	Coupon *string `json:"coupon,omitempty"`
+	// Discount of the coupon, locked when the ride was confirmed
+	Discount *int `json:"discount,omitempty"`
+	// Surge multiplier of the pickup area already applied to the prices. Locked when the ride is confirmed
+	SurgeMultiplier *float64 `json:"surge_multiplier,omitempty"`
+	// Pricing zone of the pickup point
+	Zone *string `json:"zone,omitempty"`
+	// Pickup and drop off surcharges of the zones already included in the prices
+	Surcharge *int `json:"surcharge,omitempty"`
+	// Lines of the price quoted for the selected category
+	FareBreakdown *FareBreakdown `json:"fare_breakdown,omitempty"`
+	// State of the payment of the final price
+	ChargeStatus *ChargeStatus `json:"charge_status,omitempty"`
 }
 
 // Order list filter
@@ -106,12 +247,32 @@ type OrderListFilter struct {
 
 // Order status history
 type OrderStatusHistory struct {
+	// Unique identifier of the entry
+	ID string `json:"id"`
 	// Status that the order was
 	Status OrderStatus `json:"status"`
+	// Status before the change
+	PreviousStatus *OrderStatus `json:"previous_status,omitempty"`
+	// User that changed the status
+	Actor *string `json:"actor,omitempty"`
+	// Role of the user that changed the status
+	ActorRole *Role `json:"actor_role,omitempty"`
+	// Reason of the change if any
+	Reason *string `json:"reason,omitempty"`
+	// Location of the actor when the status changed
+	Location *Point `json:"location,omitempty"`
 	// Timestamp of the status change
 	Timestamp string `json:"timestamp"`
 }
 
+// Order status history page
+type OrderTimelineResponse struct {
+	// List of the status changes, oldest first
+	Items []*OrderStatusHistory `json:"items"`
+	// Next page token
+	Token string `json:"token"`
+}
+
 type OrdersResponse struct {
 	// List of the orders
 	Items []*Order `json:"items"`
@@ -125,6 +286,8 @@ type Point struct {
 	Lat float64 `json:"lat"`
 	// Longitude
 	Lng float64 `json:"lng"`
+	// Unix timestamp in seconds, set on trip breadcrumbs
+	Timestamp *int `json:"timestamp,omitempty"`
 }
 
 // Input point information used to request a ride
@@ -156,6 +319,49 @@ type RideInput struct {
 	Baggages *bool `json:"baggages,omitempty"`
 	// Currency. Default currency is CUP
 	Currency *string `json:"currency,omitempty"`
+	// Pickup time in unix seconds to book the ride in advance. Leave empty for an immediate ride
+	ScheduledAt *int `json:"scheduledAt,omitempty"`
+	// Package to deliver. Delivery orders must be confirmed with the Package category
+	Delivery *DeliveryInput `json:"delivery,omitempty"`
+}
+
+type Stop struct {
+	ID     string     `json:"id"`
+	Point  *Point     `json:"point"`
+	Status StopStatus `json:"status"`
+	// Distance in meters of the leg ending at the stop
+	Distance float64 `json:"distance"`
+	// Duration in seconds of the leg ending at the stop
+	Duration   float64 `json:"duration"`
+	ArrivedAt  *string `json:"arrived_at,omitempty"`
+	DepartedAt *string `json:"departed_at,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type Surge struct {
+	// Grid cell of the area
+	Cell       string  `json:"cell"`
+	Multiplier float64 `json:"multiplier"`
+	// Orders waiting for a driver in the area
+	Demand int `json:"demand"`
+	// Available drivers in the area
+	Supply int `json:"supply"`
+	// The multiplier was fixed by an admin
+	Override  bool    `json:"override"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// Location of the driver during a trip
+type TrackPointInput struct {
+	// Latitude
+	Lat float64 `json:"lat"`
+	// Longitude
+	Lng float64 `json:"lng"`
+	// Unix timestamp in seconds when the position was taken
+	Timestamp int `json:"timestamp"`
 }
 
 // Card categories
@@ -210,6 +416,56 @@ func (e Category) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// State of the payment of a finished ride
+type ChargeStatus string
+
+const (
+	// Not charged yet or waiting for a retry
+	ChargeStatusPending ChargeStatus = "PENDING"
+	// Paid by the rider
+	ChargeStatusSucceeded ChargeStatus = "SUCCEEDED"
+	// Paid to the driver in cash
+	ChargeStatusReceivable ChargeStatus = "RECEIVABLE"
+	// Declined or out of attempts
+	ChargeStatusFailed ChargeStatus = "FAILED"
+)
+
+var AllChargeStatus = []ChargeStatus{
+	ChargeStatusPending,
+	ChargeStatusSucceeded,
+	ChargeStatusReceivable,
+	ChargeStatusFailed,
+}
+
+func (e ChargeStatus) IsValid() bool {
+	switch e {
+	case ChargeStatusPending, ChargeStatusSucceeded, ChargeStatusReceivable, ChargeStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ChargeStatus) String() string {
+	return string(e)
+}
+
+func (e *ChargeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChargeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChargeStatus", str)
+	}
+	return nil
+}
+
+func (e ChargeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Order status enum
 type OrderStatus string
 
@@ -217,25 +473,31 @@ const (
 	OrderStatusNew           OrderStatus = "NEW"
 	OrderStatusPending       OrderStatus = "PENDING"
 	OrderStatusAccepted      OrderStatus = "ACCEPTED"
+	OrderStatusDriverArrived OrderStatus = "DRIVER_ARRIVED"
 	OrderStatusPickedUp      OrderStatus = "PICKED_UP"
 	OrderStatusDelivered     OrderStatus = "DELIVERED"
 	OrderStatusCancelled     OrderStatus = "CANCELLED"
 	OrderStatusWaitingDriver OrderStatus = "WAITING_DRIVER"
+	OrderStatusNoDriverFound OrderStatus = "NO_DRIVER_FOUND"
+	OrderStatusScheduled     OrderStatus = "SCHEDULED"
 )
 
 var AllOrderStatus = []OrderStatus{
 	OrderStatusNew,
 	OrderStatusPending,
 	OrderStatusAccepted,
+	OrderStatusDriverArrived,
 	OrderStatusPickedUp,
 	OrderStatusDelivered,
 	OrderStatusCancelled,
 	OrderStatusWaitingDriver,
+	OrderStatusNoDriverFound,
+	OrderStatusScheduled,
 }
 
 func (e OrderStatus) IsValid() bool {
 	switch e {
-	case OrderStatusNew, OrderStatusPending, OrderStatusAccepted, OrderStatusPickedUp, OrderStatusDelivered, OrderStatusCancelled, OrderStatusWaitingDriver:
+	case OrderStatusNew, OrderStatusPending, OrderStatusAccepted, OrderStatusDriverArrived, OrderStatusPickedUp, OrderStatusDelivered, OrderStatusCancelled, OrderStatusWaitingDriver, OrderStatusNoDriverFound, OrderStatusScheduled:
 		return true
 	}
 	return false
@@ -262,6 +524,49 @@ func (e OrderStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PackageSize string
+
+const (
+	PackageSizeSmall  PackageSize = "SMALL"
+	PackageSizeMedium PackageSize = "MEDIUM"
+	PackageSizeLarge  PackageSize = "LARGE"
+)
+
+var AllPackageSize = []PackageSize{
+	PackageSizeSmall,
+	PackageSizeMedium,
+	PackageSizeLarge,
+}
+
+func (e PackageSize) IsValid() bool {
+	switch e {
+	case PackageSizeSmall, PackageSizeMedium, PackageSizeLarge:
+		return true
+	}
+	return false
+}
+
+func (e PackageSize) String() string {
+	return string(e)
+}
+
+func (e *PackageSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PackageSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PackageSize", str)
+	}
+	return nil
+}
+
+func (e PackageSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Available payment method
 type PaymentMethod string
 
@@ -317,3 +622,93 @@ func (e *PaymentMethod) UnmarshalGQL(v interface{}) error {
 func (e PaymentMethod) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// User role that triggered an order change
+type Role string
+
+const (
+	RoleRider  Role = "RIDER"
+	RoleDriver Role = "DRIVER"
+	RoleAdmin  Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleRider,
+	RoleDriver,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleRider, RoleDriver, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Status of an intermediate stop of the ride
+type StopStatus string
+
+const (
+	StopStatusPending  StopStatus = "PENDING"
+	StopStatusArrived  StopStatus = "ARRIVED"
+	StopStatusDeparted StopStatus = "DEPARTED"
+	StopStatusSkipped  StopStatus = "SKIPPED"
+)
+
+var AllStopStatus = []StopStatus{
+	StopStatusPending,
+	StopStatusArrived,
+	StopStatusDeparted,
+	StopStatusSkipped,
+}
+
+func (e StopStatus) IsValid() bool {
+	switch e {
+	case StopStatusPending, StopStatusArrived, StopStatusDeparted, StopStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func (e StopStatus) String() string {
+	return string(e)
+}
+
+func (e *StopStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StopStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StopStatus", str)
+	}
+	return nil
+}
+
+func (e StopStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
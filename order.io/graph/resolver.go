@@ -8,5 +8,7 @@ import "order.io/pkg/order"
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	order order.OrderService
+	order  order.OrderService
+	driver order.DriverService
+	surge  order.SurgeService
 }
@@ -2,7 +2,7 @@ package graph
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.43
+// Code generated by github.com/99designs/gqlgen version v0.17.45
 
 import (
 	"context"
@@ -67,11 +67,15 @@ func (r *mutationResolver) ConfirmRide(ctx context.Context, input model.ConfirmR
 }
 
 // CancelRide is the resolver for the cancelRide field.
-func (r *mutationResolver) CancelRide(ctx context.Context, id string) (*model.Response, error) {
+func (r *mutationResolver) CancelRide(ctx context.Context, id string, reason *string) (*model.Response, error) {
 	rsp := &model.Response{
 		Success: true,
 	}
-	if err := r.order.CancelOrder(ctx, id); err != nil {
+	var why string
+	if reason != nil {
+		why = *reason
+	}
+	if err := r.order.CancelOrder(ctx, id, why); err != nil {
 		rsp.Success = false
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Field:   "order",
@@ -96,6 +100,21 @@ func (r *mutationResolver) AcceptRide(ctx context.Context, id string) (*model.Re
 	return rsp, nil
 }
 
+// DriverArrived is the resolver for the driverArrived field.
+func (r *mutationResolver) DriverArrived(ctx context.Context, id string) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	if err := r.order.DriverArrived(ctx, id); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "order",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
+}
+
 // StartRide is the resolver for the startRide field.
 func (r *mutationResolver) StartRide(ctx context.Context, id string) (*model.Response, error) {
 	rsp := &model.Response{
@@ -111,40 +130,126 @@ func (r *mutationResolver) StartRide(ctx context.Context, id string) (*model.Res
 	return rsp, nil
 }
 
-// FinishRide is the resolver for the finishRide field.
-func (r *mutationResolver) FinishRide(ctx context.Context, id string) (*model.Response, error) {
+// TrackRide is the resolver for the trackRide field.
+func (r *mutationResolver) TrackRide(ctx context.Context, id string, points []*model.TrackPointInput) (*model.Response, error) {
 	rsp := &model.Response{
 		Success: true,
 	}
-	if err := r.order.FinishOrder(ctx, id); err != nil {
+	if err := r.order.Track(ctx, id, assembleTrackPoints(points)); err != nil {
 		rsp.Success = false
 		rsp.Errors = append(rsp.Errors, &model.Error{
-			Field:   "order",
+			Field:   "points",
 			Message: err.Error(),
 		})
 	}
 	return rsp, nil
 }
 
-// RateRide is the resolver for the rateRide field.
-func (r *mutationResolver) RateRide(ctx context.Context, id string, rate float64, comment *string) (*model.Response, error) {
+// StopArrived is the resolver for the stopArrived field.
+func (r *mutationResolver) StopArrived(ctx context.Context, id string, stop string) (*model.Response, error) {
 	rsp := &model.Response{
 		Success: true,
 	}
-	if err := r.order.RateOrder(ctx, id, rate, *comment); err != nil {
+	if err := r.order.StopArrived(ctx, id, stop); err != nil {
 		rsp.Success = false
 		rsp.Errors = append(rsp.Errors, &model.Error{
-			Field:   "order",
+			Field:   "stop",
 			Message: err.Error(),
 		})
 	}
 	return rsp, nil
 }
 
-// PayRide is the resolver for the payRide field.
-// TODO: move this to payment service
-func (r *mutationResolver) PayRide(ctx context.Context, id string, method model.PaymentMethod) (*model.Response, error) {
-	panic(fmt.Errorf("not implemented: PayRide - payRide"))
+// StopDeparted is the resolver for the stopDeparted field.
+func (r *mutationResolver) StopDeparted(ctx context.Context, id string, stop string) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	if err := r.order.StopDeparted(ctx, id, stop); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "stop",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
+}
+
+// AddStop is the resolver for the addStop field.
+func (r *mutationResolver) AddStop(ctx context.Context, id string, point model.PointInput, position *int) (*model.Order, error) {
+	pos := -1
+	if position != nil {
+		pos = *position
+	}
+	order, err := r.order.AddStop(ctx, id, assemblePoint(&point), pos)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelOrder(order)
+}
+
+// RemoveStop is the resolver for the removeStop field.
+func (r *mutationResolver) RemoveStop(ctx context.Context, id string, stop string) (*model.Order, error) {
+	order, err := r.order.RemoveStop(ctx, id, stop)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelOrder(order)
+}
+
+// ProveDelivery is the resolver for the proveDelivery field.
+func (r *mutationResolver) ProveDelivery(ctx context.Context, id string, proof model.DeliveryProofInput) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	proofInput, otp := assembleDeliveryProof(proof)
+	if err := r.order.ProveDelivery(ctx, id, proofInput, otp); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "proof",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
+}
+
+// SetSurgeOverride is the resolver for the setSurgeOverride field.
+func (r *mutationResolver) SetSurgeOverride(ctx context.Context, point model.PointInput, multiplier float64, expiresAt int) (*model.Surge, error) {
+	surge, err := r.surge.SetSurgeOverride(ctx, assemblePoint(&point), multiplier, int64(expiresAt))
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelSurge(surge), nil
+}
+
+// ClearSurgeOverride is the resolver for the clearSurgeOverride field.
+func (r *mutationResolver) ClearSurgeOverride(ctx context.Context, point model.PointInput) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	if err := r.surge.ClearSurgeOverride(ctx, assemblePoint(&point)); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "point",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
+}
+
+// FinishRide is the resolver for the finishRide field.
+func (r *mutationResolver) FinishRide(ctx context.Context, id string) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	if err := r.order.FinishOrder(ctx, id); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "order",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
 }
 
 // TODO: move this to models service
@@ -181,6 +286,65 @@ func (r *queryResolver) Order(ctx context.Context, id string) (*model.Order, err
 	return assembleModelOrder(order)
 }
 
+// OrderTimeline is the resolver for the orderTimeline field.
+func (r *queryResolver) OrderTimeline(ctx context.Context, id string, limit *int, token *string) (*model.OrderTimelineResponse, error) {
+	filter := order.StatusHistoryFilter{}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if token != nil {
+		filter.Token = *token
+	}
+	timeline, err := r.order.StatusHistory(ctx, id, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &model.OrderTimelineResponse{
+		Items: assembleModelStatusHistories(timeline.Data),
+		Token: timeline.Token,
+	}, nil
+}
+
+// OnlineDrivers is the resolver for the onlineDrivers field.
+func (r *queryResolver) OnlineDrivers(ctx context.Context) ([]*model.OnlineDriver, error) {
+	drivers, err := r.driver.OnlineDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelOnlineDrivers(drivers), nil
+}
+
+// Surge is the resolver for the surge field.
+func (r *queryResolver) Surge(ctx context.Context, point model.PointInput) (*model.Surge, error) {
+	surge, err := r.surge.Surge(ctx, assemblePoint(&point))
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelSurge(surge), nil
+}
+
+// Surges is the resolver for the surges field.
+func (r *queryResolver) Surges(ctx context.Context) ([]*model.Surge, error) {
+	surges, err := r.surge.Surges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*model.Surge, len(surges))
+	for i, surge := range surges {
+		items[i] = assembleModelSurge(surge)
+	}
+	return items, nil
+}
+
+// TrackDelivery is the resolver for the trackDelivery field.
+func (r *queryResolver) TrackDelivery(ctx context.Context, token string) (*model.DeliveryTracking, error) {
+	tracking, err := r.order.TrackDelivery(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelDeliveryTracking(tracking)
+}
+
 // Categories is the resolver for the categories field.
 func (r *queryResolver) Categories(ctx context.Context, order string) ([]*model.CategoryPrice, error) {
 	categories, err := r.order.Categories(ctx, order)
@@ -200,11 +364,67 @@ func (r *queryResolver) PaymentMethods(ctx context.Context) ([]model.PaymentMeth
 	}, nil
 }
 
+// OrderUpdated is the resolver for the orderUpdated field.
+func (r *subscriptionResolver) OrderUpdated(ctx context.Context, id string) (<-chan *model.Order, error) {
+	updates, err := r.order.Updates(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelOrders(ctx, updates), nil
+}
+
+// MyActiveOrder is the resolver for the myActiveOrder field.
+func (r *subscriptionResolver) MyActiveOrder(ctx context.Context) (<-chan *model.Order, error) {
+	updates, err := r.order.ActiveOrderUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelOrders(ctx, updates), nil
+}
+
+// DriverPosition is the resolver for the driverPosition field.
+func (r *subscriptionResolver) DriverPosition(ctx context.Context, id string) (<-chan *model.DriverPosition, error) {
+	positions, err := r.order.DriverPositions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelDriverPositions(ctx, positions), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//   - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//     it when you're done.
+//   - You have helper methods in this file. Move them out to keep these resolver files clean.
+/*
+	func (r *mutationResolver) RateRide(ctx context.Context, id string, rate float64, comment *string) (*model.Response, error) {
+	rsp := &model.Response{
+		Success: true,
+	}
+	if err := r.order.RateOrder(ctx, id, rate, *comment); err != nil {
+		rsp.Success = false
+		rsp.Errors = append(rsp.Errors, &model.Error{
+			Field:   "order",
+			Message: err.Error(),
+		})
+	}
+	return rsp, nil
+}
+func (r *mutationResolver) PayRide(ctx context.Context, id string, method model.PaymentMethod) (*model.Response, error) {
+	panic(fmt.Errorf("not implemented: PayRide - payRide"))
+}
+*/
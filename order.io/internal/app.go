@@ -17,6 +17,8 @@ import (
 
 	"order.io/graph"
 	"order.io/pkg/mongo"
+	"order.io/pkg/order"
+	"order.io/pkg/payment"
 	rdb "order.io/pkg/redis"
 	"order.io/pkg/seed"
 )
@@ -29,6 +31,8 @@ type App struct {
 	dialer    *gomail.Dialer
 	done      chan struct{}
 	tokenAuth *jwtauth.JWTAuth
+	charger   order.Charger
+	payments  *mongo.Payments
 }
 
 func New(cfg Config) *App {
@@ -42,7 +46,9 @@ func New(cfg Config) *App {
 		mongo:     mongo.NewDB(cfg.DB.ConnectionString(), cfg.DB.Database),
 		done:      make(chan struct{}),
 		tokenAuth: jwtauth.New("HS256", []byte(cfg.JWTPrivateKey), nil),
+		charger:   payment.NewChargers(cfg.Payment),
 	}
+	app.payments = mongo.NewPayments(app.mongo, redisDB, app.charger, payment.NewSettler(cfg.Payment), cfg.Settlement)
 
 	app.loader()
 	if v := os.Getenv("SEED"); len(v) > 0 {
@@ -76,6 +82,12 @@ func (a *App) Start(ctx context.Context) error {
 		}
 	}()
 
+	go mongo.NewDispatcher(a.mongo, a.rdb, a.charger, a.config.Dispatch).Run(ctx)
+	go mongo.NewScheduler(a.mongo, a.rdb).Run(ctx)
+	go mongo.NewSurgeUpdater(a.mongo, a.rdb, a.config.Surge).Run(ctx)
+	go a.payments.Run(ctx)
+	go rdb.NewRealTimeService(a.rdb).RunSweeper(ctx, a.config.DriverHeartbeat/2, a.config.DriverHeartbeat)
+
 	fmt.Println("Starting server on", addr)
 
 	ch := make(chan error, 1)
@@ -148,7 +160,9 @@ func (a *App) loader() {
 
 	router.Group(func(r chi.Router) {
 		grapgqlSrv := graph.NewHandler(
-			mongo.NewOrderService(a.mongo, a.rdb),
+			mongo.NewOrderService(a.mongo, a.rdb, a.config.Surge, a.payments),
+			rdb.NewRealTimeService(a.rdb),
+			rdb.NewSurgeService(a.rdb, a.config.Surge),
 		)
 
 		r.Handle("/", playground.Handler("Order playground", "/query"))
@@ -4,6 +4,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"order.io/pkg/order"
+	"order.io/pkg/payment"
 )
 
 type ServiceDiscover struct {
@@ -44,13 +49,25 @@ type Config struct {
 	DB    DB
 
 	JWTPrivateKey string
+
+	Dispatch order.DispatchPolicy
+	Surge    order.SurgePolicy
+	// DriverHeartbeat is how long a driver stays online without sending its
+	// location.
+	DriverHeartbeat time.Duration
+	Payment         payment.Config
+	Settlement      order.SettlementPolicy
 }
 
 func LoadConfig() Config {
 	cfg := Config{
-		Port:  3000,
-		Path:  "./",
-		Redis: "redis://localhost:6379",
+		Port:            3000,
+		Path:            "./",
+		Redis:           "redis://localhost:6379",
+		Dispatch:        order.DefaultDispatchPolicy,
+		Surge:           order.DefaultSurgePolicy,
+		DriverHeartbeat: time.Minute,
+		Settlement:      order.DefaultSettlementPolicy,
 		DB: DB{
 			Host:     "localhost",
 			Port:     27017,
@@ -102,6 +119,69 @@ func LoadConfig() Config {
 	if key, exist := os.LookupEnv("JWT_SECRET_KEY"); exist {
 		cfg.JWTPrivateKey = key
 	}
+	if timeout, err := strconv.ParseInt(os.Getenv("DISPATCH_OFFER_TIMEOUT"), 10, 64); err == nil {
+		cfg.Dispatch.OfferTimeout = timeout
+	}
+	if size, err := strconv.Atoi(os.Getenv("DISPATCH_BATCH_SIZE")); err == nil {
+		cfg.Dispatch.BatchSize = size
+	}
+	if radius, err := strconv.ParseFloat(os.Getenv("DISPATCH_RADIUS"), 64); err == nil {
+		cfg.Dispatch.Radius = radius
+	}
+	if step, err := strconv.ParseFloat(os.Getenv("DISPATCH_RADIUS_STEP"), 64); err == nil {
+		cfg.Dispatch.RadiusStep = step
+	}
+	if radius, err := strconv.ParseFloat(os.Getenv("DISPATCH_MAX_RADIUS"), 64); err == nil {
+		cfg.Dispatch.MaxRadius = radius
+	}
+	if deadline, err := strconv.ParseInt(os.Getenv("DISPATCH_DEADLINE"), 10, 64); err == nil {
+		cfg.Dispatch.Deadline = deadline
+	}
+	if heartbeat, err := strconv.ParseInt(os.Getenv("DRIVER_HEARTBEAT_TIMEOUT"), 10, 64); err == nil && heartbeat > 0 {
+		cfg.DriverHeartbeat = time.Duration(heartbeat) * time.Second
+	}
+	if size, err := strconv.ParseFloat(os.Getenv("SURGE_CELL_SIZE"), 64); err == nil {
+		cfg.Surge.CellSize = size
+	}
+	if sensitivity, err := strconv.ParseFloat(os.Getenv("SURGE_SENSITIVITY"), 64); err == nil {
+		cfg.Surge.Sensitivity = sensitivity
+	}
+	if multiplier, err := strconv.ParseFloat(os.Getenv("SURGE_MAX_MULTIPLIER"), 64); err == nil {
+		cfg.Surge.MaxMultiplier = multiplier
+	}
+	if smoothing, err := strconv.ParseFloat(os.Getenv("SURGE_SMOOTHING"), 64); err == nil {
+		cfg.Surge.Smoothing = smoothing
+	}
+	if walletURL, exist := os.LookupEnv("WALLET_URL"); exist {
+		cfg.Payment.WalletURL = walletURL
+	}
+	if walletKey, exist := os.LookupEnv("WALLET_CLIENT_KEY"); exist {
+		cfg.Payment.WalletKey = walletKey
+	}
+	// PAYMENT_FAKE_METHODS pays the listed charge methods with a fake
+	// provider, for development and tests only.
+	if methods, exist := os.LookupEnv("PAYMENT_FAKE_METHODS"); exist {
+		for _, m := range strings.Split(methods, ",") {
+			method := order.ChargeMethod(strings.TrimSpace(m))
+			if !method.IsValid() {
+				panic(fmt.Sprintf("invalid fake charge method %q", m))
+			}
+			cfg.Payment.Fake = append(cfg.Payment.Fake, method)
+		}
+	}
+	if commission, err := strconv.ParseFloat(os.Getenv("PLATFORM_COMMISSION"), 64); err == nil {
+		cfg.Settlement.Commission = commission
+	}
+	if err := cfg.Dispatch.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid dispatch config: %v", err))
+	}
+	if err := cfg.Surge.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid surge config: %v", err))
+	}
+	if err := cfg.Settlement.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid settlement config: %v", err))
+	}
+
 	if cfg.JWTPrivateKey == "" {
 		panic("JWT_SECRET_KEY is not set")
 	}
@@ -0,0 +1,18 @@
+package mock
+
+import (
+	"context"
+
+	"order.io/pkg/order"
+)
+
+var _ order.DriverService = &DriverService{}
+
+type DriverService struct {
+	OnlineDriversFunc func(context.Context) ([]*order.OnlineDriver, error)
+}
+
+// OnlineDrivers implements order.DriverService.
+func (s *DriverService) OnlineDrivers(ctx context.Context) ([]*order.OnlineDriver, error) {
+	return s.OnlineDriversFunc(ctx)
+}
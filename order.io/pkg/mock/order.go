@@ -21,13 +21,38 @@ type OrderService struct {
 	StartOrderFunc   func(context.Context, string) (*order.Order, error)
 }
 
+// AddStop implements order.OrderService.
+func (*OrderService) AddStop(context.Context, string, order.Point, int) (*order.Order, error) {
+	panic("unimplemented")
+}
+
+// RemoveStop implements order.OrderService.
+func (*OrderService) RemoveStop(context.Context, string, string) (*order.Order, error) {
+	panic("unimplemented")
+}
+
+// StopArrived implements order.OrderService.
+func (*OrderService) StopArrived(context.Context, string, string) error {
+	panic("unimplemented")
+}
+
+// StopDeparted implements order.OrderService.
+func (*OrderService) StopDeparted(context.Context, string, string) error {
+	panic("unimplemented")
+}
+
+// ActiveOrderUpdates implements order.OrderService.
+func (*OrderService) ActiveOrderUpdates(context.Context) (<-chan *order.Order, error) {
+	panic("unimplemented")
+}
+
 // AcceptOrder implements order.OrderService.
 func (*OrderService) AcceptOrder(context.Context, string) error {
 	panic("unimplemented")
 }
 
 // CancelOrder implements order.OrderService.
-func (*OrderService) CancelOrder(context.Context, string) error {
+func (*OrderService) CancelOrder(context.Context, string, string) error {
 	panic("unimplemented")
 }
 
@@ -46,11 +71,21 @@ func (*OrderService) Create(context.Context, order.Item) (*order.Order, error) {
 	panic("unimplemented")
 }
 
+// DriverArrived implements order.OrderService.
+func (*OrderService) DriverArrived(context.Context, string) error {
+	panic("unimplemented")
+}
+
 // FindAll implements order.OrderService.
 func (*OrderService) FindAll(context.Context, order.OrderFilter) (*order.OrderList, error) {
 	panic("unimplemented")
 }
 
+// DriverPositions implements order.OrderService.
+func (*OrderService) DriverPositions(context.Context, string) (<-chan *order.DriverPosition, error) {
+	panic("unimplemented")
+}
+
 // FindByID implements order.OrderService.
 func (*OrderService) FindByID(context.Context, string) (*order.Order, error) {
 	panic("unimplemented")
@@ -66,12 +101,37 @@ func (*OrderService) RateOrder(context.Context, string, float64, string) error {
 	panic("unimplemented")
 }
 
+// StatusHistory implements order.OrderService.
+func (*OrderService) StatusHistory(context.Context, string, order.StatusHistoryFilter) (*order.StatusHistoryList, error) {
+	panic("unimplemented")
+}
+
 // StartOrder implements order.OrderService.
 func (*OrderService) StartOrder(context.Context, string) error {
 	panic("unimplemented")
 }
 
+// Track implements order.OrderService.
+func (*OrderService) Track(context.Context, string, []*order.Point) error {
+	panic("unimplemented")
+}
+
+// Updates implements order.OrderService.
+func (*OrderService) Updates(context.Context, string) (<-chan *order.Order, error) {
+	panic("unimplemented")
+}
+
 // Update implements order.OrderService.
 func (*OrderService) Update(context.Context, string, order.Item) (*order.Order, error) {
 	panic("unimplemented")
 }
+
+// ProveDelivery implements order.OrderService.
+func (*OrderService) ProveDelivery(context.Context, string, order.DeliveryProof, string) error {
+	panic("unimplemented")
+}
+
+// TrackDelivery implements order.OrderService.
+func (*OrderService) TrackDelivery(context.Context, string) (*order.DeliveryTracking, error) {
+	panic("unimplemented")
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"order.io/pkg/order"
+)
+
+var _ order.SurgeService = &SurgeService{}
+
+type SurgeService struct {
+	SurgeFunc              func(context.Context, order.Point) (*order.Surge, error)
+	SurgesFunc             func(context.Context) ([]*order.Surge, error)
+	SetSurgeOverrideFunc   func(context.Context, order.Point, float64, int64) (*order.Surge, error)
+	ClearSurgeOverrideFunc func(context.Context, order.Point) error
+}
+
+// Surge implements order.SurgeService.
+func (s *SurgeService) Surge(ctx context.Context, p order.Point) (*order.Surge, error) {
+	return s.SurgeFunc(ctx, p)
+}
+
+// Surges implements order.SurgeService.
+func (s *SurgeService) Surges(ctx context.Context) ([]*order.Surge, error) {
+	return s.SurgesFunc(ctx)
+}
+
+// SetSurgeOverride implements order.SurgeService.
+func (s *SurgeService) SetSurgeOverride(ctx context.Context, p order.Point, multiplier float64, expiresAt int64) (*order.Surge, error) {
+	return s.SetSurgeOverrideFunc(ctx, p, multiplier, expiresAt)
+}
+
+// ClearSurgeOverride implements order.SurgeService.
+func (s *SurgeService) ClearSurgeOverride(ctx context.Context, p order.Point) error {
+	return s.ClearSurgeOverrideFunc(ctx, p)
+}
@@ -0,0 +1,259 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"order.io/pkg/order"
+)
+
+var _ order.CouponService = &CouponService{}
+
+const CouponCollection Collections = "coupons"
+
+type CouponService struct {
+	db *DB
+}
+
+func NewCouponService(db *DB) *CouponService {
+	return &CouponService{
+		db: db,
+	}
+}
+
+// Create implements order.CouponService.
+func (s *CouponService) Create(ctx context.Context, req order.CouponRequest) (*order.Coupon, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	var coupon order.Coupon
+	assembleCoupon(&coupon, req)
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+	_, err := findCouponByCode(ctx, s.db, coupon.Code)
+	if err == nil {
+		return nil, fmt.Errorf("coupon %s: %w", coupon.Code, order.ErrExist)
+	}
+	if !errors.Is(err, order.ErrNotFound) {
+		return nil, err
+	}
+	if _, err := s.db.Collection(CouponCollection).InsertOne(ctx, &coupon); err != nil {
+		return nil, fmt.Errorf("unable to store the coupon: %v: %w", err, order.ErrInternal)
+	}
+	return &coupon, nil
+}
+
+// Update implements order.CouponService. The redemption counters are kept.
+func (s *CouponService) Update(ctx context.Context, req order.CouponRequest) (*order.Coupon, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	coupon, err := findCouponByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	code := coupon.Code
+	assembleCoupon(coupon, req)
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+	if coupon.Code != code {
+		return nil, fmt.Errorf("coupon code cannot be changed: %w", order.ErrInvalidInput)
+	}
+	set := bson.D{
+		{Key: "type", Value: coupon.Type},
+		{Key: "value", Value: coupon.Value},
+		{Key: "max_discount", Value: coupon.MaxDiscount},
+		{Key: "currency", Value: coupon.Currency},
+		{Key: "start_at", Value: coupon.StartAt},
+		{Key: "end_at", Value: coupon.EndAt},
+		{Key: "max_uses", Value: coupon.MaxUses},
+		{Key: "max_uses_per_user", Value: coupon.MaxUsesPerUser},
+		{Key: "first_ride_only", Value: coupon.FirstRideOnly},
+		{Key: "categories", Value: coupon.Categories},
+		{Key: "disabled", Value: coupon.Disabled},
+	}
+	_, err = s.db.Collection(CouponCollection).UpdateOne(ctx, bson.D{{Key: "_id", Value: coupon.ID}}, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to update coupon: %v: %w", err, order.ErrInternal)
+	}
+	return coupon, nil
+}
+
+// FindByID implements order.CouponService.
+func (s *CouponService) FindByID(ctx context.Context, id string) (*order.Coupon, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	return findCouponByID(ctx, s.db, id)
+}
+
+// FindByCode implements order.CouponService.
+func (s *CouponService) FindByCode(ctx context.Context, code string) (*order.Coupon, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	return findCouponByCode(ctx, s.db, code)
+}
+
+// FindAll implements order.CouponService.
+func (s *CouponService) FindAll(ctx context.Context, filter order.CouponFilter) ([]*order.Coupon, string, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, "", order.ErrAccessDenied
+	}
+	return findCoupons(ctx, s.db, filter)
+}
+
+func findCoupons(ctx context.Context, db *DB, filter order.CouponFilter) ([]*order.Coupon, string, error) {
+	var coupons []*order.Coupon
+	var token string
+	f := bson.D{}
+	if len(filter.Ids) > 0 {
+		f = append(f, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: filter.Ids}}})
+	}
+	if len(filter.Code) > 0 {
+		codes := make([]string, len(filter.Code))
+		for i, code := range filter.Code {
+			codes[i] = order.NormalizeCouponCode(code)
+		}
+		f = append(f, bson.E{Key: "code", Value: bson.D{{Key: "$in", Value: codes}}})
+	}
+	if filter.Token != "" {
+		f = append(f, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: filter.Token}}})
+	}
+	cur, err := db.Collection(CouponCollection).Find(ctx, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to find coupons: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var coupon order.Coupon
+		if err := cur.Decode(&coupon); err != nil {
+			return nil, "", fmt.Errorf("unable to decode coupon: %v: %w", err, order.ErrInternal)
+		}
+		coupons = append(coupons, &coupon)
+		if len(coupons) == filter.Limit+1 && filter.Limit > 0 {
+			token = coupons[filter.Limit].ID
+			coupons = coupons[:filter.Limit]
+			break
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, "", fmt.Errorf("unable to iterate over coupons: %v: %w", err, order.ErrInternal)
+	}
+	return coupons, token, nil
+}
+
+func findCouponByID(ctx context.Context, db *DB, id string) (*order.Coupon, error) {
+	coupons, _, err := findCoupons(ctx, db, order.CouponFilter{Ids: []string{id}, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(coupons) == 0 {
+		return nil, fmt.Errorf("unable to find coupon: %v: %w", id, order.ErrNotFound)
+	}
+	return coupons[0], nil
+}
+
+func findCouponByCode(ctx context.Context, db *DB, code string) (*order.Coupon, error) {
+	coupons, _, err := findCoupons(ctx, db, order.CouponFilter{Code: []string{code}, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(coupons) == 0 {
+		return nil, fmt.Errorf("unable to find coupon: %v: %w", code, order.ErrNotFound)
+	}
+	return coupons[0], nil
+}
+
+// redeemCoupon counts a redemption of the coupon by the user. The limits are
+// checked in the same update, so concurrent confirmations cannot exceed them.
+func redeemCoupon(ctx context.Context, db *DB, coupon *order.Coupon, user string) error {
+	userUses := "user_uses." + user
+	f := bson.D{{Key: "_id", Value: coupon.ID}}
+	if coupon.MaxUses > 0 {
+		f = append(f, bson.E{Key: "uses", Value: bson.D{{Key: "$lt", Value: coupon.MaxUses}}})
+	}
+	if coupon.MaxUsesPerUser > 0 {
+		f = append(f, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: userUses, Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: userUses, Value: bson.D{{Key: "$lt", Value: coupon.MaxUsesPerUser}}}},
+		}})
+	}
+	update := bson.D{{Key: "$inc", Value: bson.D{
+		{Key: "uses", Value: 1},
+		{Key: userUses, Value: 1},
+	}}}
+	err := db.Collection(CouponCollection).FindOneAndUpdate(ctx, f, update).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return order.NewInvalidParameter("coupon", "coupon was fully redeemed")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to redeem coupon: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// releaseCoupon gives back the redemption of the coupon of a canceled order.
+// The order must have been stored with the redemption cleared first, so it is
+// released once.
+func releaseCoupon(ctx context.Context, db *DB, code, user string) {
+	f := bson.D{{Key: "code", Value: code}}
+	update := bson.D{{Key: "$inc", Value: bson.D{
+		{Key: "uses", Value: -1},
+		{Key: "user_uses." + user, Value: -1},
+	}}}
+	if _, err := db.Collection(CouponCollection).UpdateOne(ctx, f, update); err != nil {
+		slog.Info("unable to release coupon", "coupon", code, "user", user, "error", err)
+	}
+}
+
+// countRides returns the number of rides the rider confirmed and did not
+// cancel, other than the order. Rides still running count, so a first ride
+// coupon cannot be redeemed again before the first ride finishes.
+func countRides(ctx context.Context, db *DB, rider, except string) (int, error) {
+	f := bson.D{
+		{Key: "rider", Value: rider},
+		{Key: "_id", Value: bson.D{{Key: "$ne", Value: except}}},
+		{Key: "status", Value: bson.D{{Key: "$nin", Value: bson.A{order.OrderStatusNew, order.OrderStatusCancel}}}},
+	}
+	n, err := db.Collection(OrderCollection).CountDocuments(ctx, f)
+	if err != nil {
+		return 0, fmt.Errorf("unable to count rides: %v: %w", err, order.ErrInternal)
+	}
+	return int(n), nil
+}
+
+func assembleCoupon(coupon *order.Coupon, req order.CouponRequest) {
+	if coupon.ID == "" {
+		coupon.ID = req.ID
+	}
+	if coupon.ID == "" {
+		coupon.ID = order.NewID().String()
+	}
+	if req.Code != "" {
+		coupon.Code = order.NormalizeCouponCode(req.Code)
+	}
+	coupon.Type = req.Type
+	coupon.Value = req.Value
+	coupon.MaxDiscount = req.MaxDiscount
+	coupon.Currency = req.Currency
+	coupon.StartAt = req.StartAt
+	coupon.EndAt = req.EndAt
+	coupon.MaxUses = req.MaxUses
+	coupon.MaxUsesPerUser = req.MaxUsesPerUser
+	coupon.FirstRideOnly = req.FirstRideOnly
+	coupon.Categories = req.Categories
+	coupon.Disabled = req.Disabled
+}
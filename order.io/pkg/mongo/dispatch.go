@@ -0,0 +1,212 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"order.io/pkg/order"
+	"order.io/pkg/redis"
+)
+
+// dispatcher is the actor recorded in the status history of the orders
+// the dispatcher gives up on.
+var dispatcher = &order.User{ID: "dispatcher", Role: order.RoleAdmin}
+
+// Dispatcher offers the confirmed orders to the best ranked nearby drivers,
+// a few at a time, until one of them accepts or the policy deadline passes.
+type Dispatcher struct {
+	db       *DB
+	redis    *redis.Redis
+	realtime *redis.RealTimeService
+	charger  order.Charger
+	policy   order.DispatchPolicy
+	interval time.Duration
+}
+
+func NewDispatcher(db *DB, rdb *redis.Redis, charger order.Charger, policy order.DispatchPolicy) *Dispatcher {
+	return &Dispatcher{
+		db:       db,
+		redis:    rdb,
+		realtime: redis.NewRealTimeService(rdb),
+		charger:  charger,
+		policy:   policy,
+		interval: time.Second,
+	}
+}
+
+// Run dispatches the orders waiting for a driver until the context is done.
+// Orders published on order.ChannelOrders are dispatched right away, the rest
+// are checked on every interval to expire offers and escalate the radius.
+func (d *Dispatcher) Run(ctx context.Context) {
+	pubsub := d.redis.Subscripe(ctx, order.ChannelOrders)
+	defer pubsub.Close()
+	messages := pubsub.Channel()
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			var ord order.Order
+			if err := json.Unmarshal([]byte(msg.Payload), &ord); err != nil {
+				slog.Info("unable to decode order", "error", err)
+				continue
+			}
+			d.dispatchByID(ctx, ord.ID)
+		case <-ticker.C:
+			orders, err := findUndispatchedOrders(ctx, d.db)
+			if err != nil {
+				slog.Info("unable to find orders to dispatch", "error", err)
+				continue
+			}
+			for _, ord := range orders {
+				d.dispatch(ctx, ord)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchByID(ctx context.Context, id string) {
+	var ord order.Order
+	err := d.db.Collection(OrderCollection).FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&ord)
+	if err != nil {
+		slog.Info("unable to find order to dispatch", "order", id, "error", err)
+		return
+	}
+	d.dispatch(ctx, &ord)
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, ord *order.Order) {
+	if err := d.Dispatch(ctx, ord, time.Now()); err != nil && !errors.Is(err, order.ErrConflict) {
+		slog.Info("unable to dispatch order", "order", ord.ID, "error", err)
+	}
+}
+
+// Dispatch makes the next offers of the order. Orders nobody accepted before
+// the deadline move to order.OrderStatusNoDriver.
+func (d *Dispatcher) Dispatch(ctx context.Context, ord *order.Order, now time.Time) error {
+	switch ord.Status {
+	case order.OrderStatusConfirmed, order.OrderStatusWaitingDriver:
+	default:
+		return nil
+	}
+	if ord.DispatchExpired(d.policy, now) {
+		change := order.StatusChange{Actor: dispatcher, Reason: "no driver accepted the order"}
+		if err := ord.Transition(order.OrderStatusNoDriver, change); err != nil {
+			return err
+		}
+		release := ord.CouponRedeemed
+		ord.CouponRedeemed = false
+		unassigned := bson.E{Key: "driver", Value: bson.D{{Key: "$in", Value: bson.A{"", nil}}}}
+		if err := updateOrder(ctx, d.db, ord, unassigned); err != nil {
+			return err
+		}
+		if release {
+			releaseCoupon(ctx, d.db, ord.Coupon, ord.Rider)
+		}
+		voidCharge(ctx, d.charger, ord)
+		return d.redis.Publish(ctx, order.ChannelOrderUpdated, ord)
+	}
+	if len(ord.Item.Points) == 0 {
+		return fmt.Errorf("order %s has no pickup point: %w", ord.ID, order.ErrInvalidInput)
+	}
+
+	var before order.Dispatch
+	if ord.Dispatch != nil {
+		before = *ord.Dispatch
+	}
+	candidates, err := d.realtime.Candidates(ctx, ord, ord.DispatchRadius(d.policy))
+	if err != nil {
+		return err
+	}
+	if err := rateCandidates(ctx, d.db, candidates); err != nil {
+		return err
+	}
+	offers := ord.NextOffers(d.policy, candidates, now)
+	if before.StartedAt != 0 && before.Radius == ord.Dispatch.Radius && len(offers) == 0 {
+		return nil
+	}
+	unassigned := bson.E{Key: "driver", Value: bson.D{{Key: "$in", Value: bson.A{"", nil}}}}
+	if err := updateOrder(ctx, d.db, ord, unassigned); err != nil {
+		return err
+	}
+	if len(offers) == 0 {
+		return nil
+	}
+	for _, offer := range offers {
+		if err := d.realtime.RecordOffer(ctx, offer.Driver); err != nil {
+			slog.Info("unable to record driver offer", "driver", offer.Driver, "error", err)
+		}
+	}
+	return d.redis.Publish(ctx, order.ChannelOrderOffers, order.OfferMessage{Order: ord, Offers: offers})
+}
+
+// rateCandidates sets the rating of the candidates to the average rating the
+// riders gave to their rides.
+func rateCandidates(ctx context.Context, db *DB, candidates []*order.Candidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	drivers := make(bson.A, len(candidates))
+	for i, c := range candidates {
+		drivers[i] = c.Driver
+	}
+	pipeline := bson.A{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "driver", Value: bson.D{{Key: "$in", Value: drivers}}},
+			{Key: "rate", Value: bson.D{{Key: "$gt", Value: 0}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$driver"},
+			{Key: "rating", Value: bson.D{{Key: "$avg", Value: "$rate"}}},
+		}}},
+	}
+	cur, err := db.Collection(OrderCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("unable to aggregate driver ratings: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var ratings []struct {
+		Driver string  `bson:"_id"`
+		Rating float64 `bson:"rating"`
+	}
+	if err := cur.All(ctx, &ratings); err != nil {
+		return fmt.Errorf("unable to decode driver ratings: %v: %w", err, order.ErrInternal)
+	}
+	byDriver := make(map[string]float64, len(ratings))
+	for _, r := range ratings {
+		byDriver[r.Driver] = r.Rating
+	}
+	for _, c := range candidates {
+		c.Rating = byDriver[c.Driver]
+	}
+	return nil
+}
+
+// findUndispatchedOrders returns the orders waiting for a driver.
+func findUndispatchedOrders(ctx context.Context, db *DB) ([]*order.Order, error) {
+	f := bson.D{{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{
+		order.OrderStatusConfirmed,
+		order.OrderStatusWaitingDriver,
+	}}}}}
+	cur, err := db.Collection(OrderCollection).Find(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find orders: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var orders []*order.Order
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, fmt.Errorf("unable to decode orders: %v: %w", err, order.ErrInternal)
+	}
+	return orders, nil
+}
@@ -0,0 +1,74 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"order.io/pkg/order"
+	rdb "order.io/pkg/redis"
+)
+
+func NewTestRedis() *rdb.Redis {
+	return rdb.NewRedis(redis.NewClient(&redis.Options{Addr: "localhost:6379"}))
+}
+
+func TestDispatcherOffersOnlineDrivers(t *testing.T) {
+	ctx := context.Background()
+	db := NewTestDB()
+	cache := NewTestRedis()
+	realtime := rdb.NewRealTimeService(cache)
+	defer func() {
+		db.Collection(OrderCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+		cache.Close()
+	}()
+
+	pickup := &order.Point{Lat: 23.1136, Lng: -82.3666}
+	online := func(driver string) {
+		t.Helper()
+		if err := realtime.SetAvailable(ctx, driver, true); err != nil {
+			t.Fatal(err)
+		}
+		location := order.GeoLocation{Lat: pickup.Lat, Long: pickup.Lng}
+		if err := realtime.UpdateLocation(ctx, driver, location); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the courier only serves packages, the other driver has no tag yet
+	courier := order.NewID().String()
+	online(courier)
+	if err := realtime.SetVehicle(ctx, courier, &order.DriverVehicle{Category: order.VehicleCategoryPackage}); err != nil {
+		t.Fatal(err)
+	}
+	driver := order.NewID().String()
+	online(driver)
+	defer func() {
+		realtime.SetAvailable(ctx, courier, false)
+		realtime.SetAvailable(ctx, driver, false)
+	}()
+
+	ord := &order.Order{
+		ID:               order.NewID().String(),
+		Status:           order.OrderStatusConfirmed,
+		Item:             order.Item{Points: []*order.Point{pickup}, Riders: 1},
+		SelectedCategory: &order.CategoryPrice{Category: order.VehicleCategoryX},
+	}
+	if err := storeOrder(ctx, db, ord); err != nil {
+		t.Fatal(err)
+	}
+	policy := order.DefaultDispatchPolicy
+	policy.BatchSize = 2
+	now := time.Now()
+	if err := NewDispatcher(db, cache, nil, policy).Dispatch(ctx, ord, now); err != nil {
+		t.Fatal(err)
+	}
+	if ord.Dispatch == nil || len(ord.Dispatch.Offers) != 1 || ord.Dispatch.Offers[0].Driver != driver {
+		t.Fatalf("Dispatcher.Dispatch() offers = %+v, want one to %s", ord.Dispatch, driver)
+	}
+	if !ord.Offered(driver, now) || ord.Offered(courier, now) {
+		t.Fatal("Dispatcher.Dispatch() offered the order to the wrong drivers")
+	}
+}
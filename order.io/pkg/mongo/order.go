@@ -5,11 +5,15 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
-	"sync"
+	"slices"
 	"time"
 
+	"github.com/goccy/go-json"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"order.io/pkg/currency"
 	"order.io/pkg/derrors"
@@ -25,22 +29,29 @@ var OrderCollection Collections = "orders"
 type OrderService struct {
 	db        *DB
 	orderChan chan *order.Order
-	mutex     sync.Map
 	redis     *redis.Redis
+	realtime  *redis.RealTimeService
+	surge     *redis.SurgeService
 	direction order.DirectionService
+	payments  *Payments
 }
 
 func NewOrderService(
 	db *DB,
-	redis *redis.Redis,
+	rdb *redis.Redis,
+	surge order.SurgePolicy,
+	payments *Payments,
 ) *OrderService {
 	client := mapbox.NewClient(os.Getenv("MAPBOX_TOKEN"))
 
 	return &OrderService{
 		db:        db,
 		orderChan: make(chan *order.Order, 10000),
-		redis:     redis,
+		redis:     rdb,
+		realtime:  redis.NewRealTimeService(rdb),
+		surge:     redis.NewSurgeService(rdb, surge),
 		direction: client.Directions,
+		payments:  payments,
 	}
 }
 
@@ -51,8 +62,29 @@ func (s *OrderService) Create(ctx context.Context, req order.Item) (_ *order.Ord
 		return nil, fmt.Errorf("nil user in context: %w", order.ErrAccessDenied)
 	}
 
-	o, err := s.prepareOrder(ctx, nil, order.DirectionRequest{
-		Points: req.Points,
+	if err := order.ValidateSchedule(req.ScheduledAt, time.Now()); err != nil {
+		return nil, err
+	}
+	if req.Delivery != nil {
+		if err := req.Delivery.Validate(); err != nil {
+			return nil, err
+		}
+		if err := req.Delivery.Prepare(); err != nil {
+			return nil, err
+		}
+		req.Delivery.Proof = nil
+	}
+	o, err := s.prepareOrder(ctx, &order.Order{
+		Status:      order.OrderStatusNew,
+		CreatedAt:   time.Now().UTC().Unix(),
+		ScheduledAt: req.ScheduledAt,
+		Delivery:    req.Delivery,
+	}, order.DirectionRequest{
+		Points:   req.Points,
+		Riders:   req.Riders,
+		Baggages: req.Baggages,
+		Coupon:   req.Coupon,
+		Currency: req.Currency,
 	})
 	if err != nil {
 		return nil, err
@@ -68,8 +100,6 @@ func (s *OrderService) Create(ctx context.Context, req order.Item) (_ *order.Ord
 
 // ConfirmOrder implements order.OrderService.
 func (s *OrderService) ConfirmOrder(ctx context.Context, req order.ConfirmOrder) error {
-	s.orderLock(req.OrderID)
-	defer s.orderUnlock(req.OrderID)
 	usr := order.UserFromContext(ctx)
 	if usr == nil || usr.Role != order.RoleRider {
 		return order.ErrAccessDenied
@@ -81,26 +111,65 @@ func (s *OrderService) ConfirmOrder(ctx context.Context, req order.ConfirmOrder)
 	if ord.Rider != usr.ID {
 		return order.ErrAccessDenied
 	}
-	if ord.Status != order.OrderStatusNew {
-		return order.ErrNotFound
+	if ord.Delivery != nil && req.Category != order.VehicleCategoryPackage {
+		return order.NewInvalidParameter("category", req.Category)
+	}
+	// Scheduled rides are offered to the drivers by the scheduler ahead of
+	// the pickup time.
+	next := order.OrderStatusConfirmed
+	if ord.ScheduledAt != 0 {
+		if err := order.ValidateSchedule(ord.ScheduledAt, time.Now()); err != nil {
+			return err
+		}
+		next = order.OrderStatusScheduled
+	}
+	if err := ord.Transition(next, s.statusChange(ctx, usr, "")); err != nil {
+		return err
 	}
-	ord.Status = order.OrderStatusConfirmed
 	for _, c := range ord.CategoryPrice {
 		if c.Category == req.Category {
 			ord.Price = int(c.Price)
+			ord.QuotedPrice = ord.Price + c.Discount
+			ord.Discount = c.Discount
 			ord.SelectedCategory = c
 			break
 		}
 	}
 	ord.ChargeMethod = req.Method
-	if err := updateOrder(ctx, s.db, ord.ID, ord); err != nil {
+	// The coupon is redeemed once per order, when it discounts the selected
+	// category.
+	redeem := ord.Coupon != "" && ord.Discount > 0 && !ord.CouponRedeemed
+	if redeem {
+		coupon, err := findCouponByCode(ctx, s.db, ord.Coupon)
+		if err != nil {
+			return err
+		}
+		if err := s.checkCoupon(ctx, coupon, ord); err != nil {
+			return err
+		}
+		if err := redeemCoupon(ctx, s.db, coupon, ord.Rider); err != nil {
+			return err
+		}
+		ord.CouponRedeemed = true
+	}
+	if err := authorizeCharge(ctx, s.payments.charger, ord); err != nil {
+		if redeem {
+			releaseCoupon(ctx, s.db, ord.Coupon, ord.Rider)
+		}
 		return err
 	}
-	if err := updateOrder(ctx, s.db, ord.ID, ord); err != nil {
+	if err := updateOrder(ctx, s.db, ord); err != nil {
+		if redeem {
+			releaseCoupon(ctx, s.db, ord.Coupon, ord.Rider)
+		}
+		voidCharge(ctx, s.payments.charger, ord)
 		return err
 	}
+	if ord.Status == order.OrderStatusScheduled {
+		return nil
+	}
 
-	if err := s.redis.Publish(ctx, "orders", ord); err != nil {
+	if err := s.redis.Publish(ctx, order.ChannelOrders, ord); err != nil {
 		return err
 	}
 	return nil
@@ -113,35 +182,118 @@ func (s *OrderService) CalculatePrice(o *order.Order) error {
 		return err
 	}
 	// Calculate the price of the trip and store it in the order
-	rates, _, err := findRates(context.Background(), s.db, &order.RateFilter{})
-	if err != nil {
-		return err
-	}
-	if len(rates) == 0 {
-		return fmt.Errorf("no rates found: %w", order.ErrNotFound)
-	}
-	var rate *order.Rate = rates[0]
-	for _, r := range rates {
-		if checkRate(r) {
-			rate = r
-			break
+	rate := o.AppliedRate
+	if !o.RateLocked() {
+		rate, err = s.selectRate(o)
+		if err != nil {
+			return err
 		}
+		o.AppliedRate = rate
+		o.CancellationPolicy = &rate.Cancellation
+		o.WaitingPolicy = &rate.Waiting
 	}
-	if rate == nil {
-		return fmt.Errorf("no rate found: %w", order.ErrNotFound)
+	// The surge is locked once the order is confirmed.
+	if o.Status == order.OrderStatusNew || o.SurgeMultiplier == 0 {
+		o.SurgeMultiplier = s.surgeMultiplier(o)
 	}
-	price := price(o.Distance, o.Duration, *rate, o.Item.Riders)
 
+	o.CategoryPrice = nil
 	for _, b := range brands {
+		breakdown := rate.Breakdown(o.Distance, o.Duration, o.Item.Riders, b.Factor, o.SurgeMultiplier, o.Surcharge)
 		o.CategoryPrice = append(o.CategoryPrice, &order.CategoryPrice{
-			Category: b.Category,
-			Price:    int(float64(price) * b.Factor),
-			Currency: o.Currency,
+			Category:  b.Category,
+			Price:     breakdown.Total(),
+			Currency:  o.Currency,
+			Factor:    b.Factor,
+			Breakdown: breakdown,
 		})
 	}
 	return nil
 }
 
+// selectRate returns the rate of the zone of the order applying at the
+// pickup time, or else the global one. The price of scheduled rides is locked
+// with the rate of the pickup time.
+func (s *OrderService) selectRate(o *order.Order) (*order.Rate, error) {
+	zoneRates, globalRates, err := s.zoneRates(context.Background(), o.Zone)
+	if err != nil {
+		return nil, err
+	}
+	pickup := o.PickupTime(time.Now())
+	rate, err := order.SelectRate(zoneRates, pickup, o.Distance)
+	if errors.Is(err, order.ErrNotFound) {
+		rate, err = order.SelectRate(globalRates, pickup, o.Distance)
+	}
+	return rate, err
+}
+
+// zoneRates returns the rates of the zone, if any, and the rates not
+// attached to any zone, used when no rate of the zone applies. The rates of
+// the other zones are never returned, so a ride no rate applies to is not
+// priced.
+func (s *OrderService) zoneRates(ctx context.Context, zoneID string) (zoneRates, globalRates []*order.Rate, err error) {
+	rates, _, err := findRates(ctx, s.db, &order.RateFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+	zones, _, err := findZones(ctx, s.db, order.ZoneFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+	attached := make(map[string]bool)
+	var codes []string
+	for _, z := range zones {
+		for _, code := range z.Rates {
+			attached[code] = true
+		}
+		if z.ID == zoneID {
+			codes = z.Rates
+		}
+	}
+	for _, r := range rates {
+		switch {
+		case slices.Contains(codes, r.Code):
+			zoneRates = append(zoneRates, r)
+		case !attached[r.Code]:
+			globalRates = append(globalRates, r)
+		}
+	}
+	return zoneRates, globalRates, nil
+}
+
+// matchZones checks the route is served and sets the zone and surcharges of
+// the order.
+func (s *OrderService) matchZones(ctx context.Context, o *order.Order, points []*order.Point) error {
+	zones, _, err := findZones(ctx, s.db, order.ZoneFilter{})
+	if err != nil {
+		return err
+	}
+	match, err := order.MatchZones(zones, points)
+	if err != nil {
+		return err
+	}
+	o.Zone = ""
+	if match.Zone != nil {
+		o.Zone = match.Zone.ID
+	}
+	o.Surcharge = match.Surcharge
+	return nil
+}
+
+// surgeMultiplier returns the surge of the pickup cell of the order. Rides
+// booked in advance are not surged.
+func (s *OrderService) surgeMultiplier(o *order.Order) float64 {
+	if o.ScheduledAt != 0 || len(o.Item.Points) == 0 {
+		return 1
+	}
+	surge, err := s.surge.Surge(context.Background(), *o.Item.Points[0])
+	if err != nil {
+		slog.Info("unable to get surge", "order", o.ID, "error", err)
+		return 1
+	}
+	return max(surge.Multiplier, 1)
+}
+
 // Request with brand and price
 // Accept the order for the client
 // Send the order to the near by drivers with are riding a vehicle of the same brand
@@ -159,13 +311,31 @@ func (s *OrderService) Update(ctx context.Context, id string, req order.Item) (_
 	if err != nil {
 		return nil, err
 	}
+	if o.Status.IsTerminal() {
+		return nil, fmt.Errorf("order %s is %s: %w", o.ID, o.Status, order.ErrInvalidTransition)
+	}
+	previous := o.Price
+	if req.ScheduledAt != 0 && req.ScheduledAt != o.ScheduledAt {
+		if o.Status != order.OrderStatusNew {
+			return nil, fmt.Errorf("order %s is %s and cannot be rescheduled: %w", o.ID, o.Status, order.ErrInvalidTransition)
+		}
+		if err := order.ValidateSchedule(req.ScheduledAt, time.Now()); err != nil {
+			return nil, err
+		}
+		o.ScheduledAt = req.ScheduledAt
+	}
 	o, err = s.prepareOrder(ctx, o, order.DirectionRequest{
-		Points: req.Points,
+		Points:   req.Points,
+		Riders:   req.Riders,
+		Baggages: req.Baggages,
+		Coupon:   req.Coupon,
+		Currency: req.Currency,
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := updateOrder(ctx, s.db, o.ID, o); err != nil {
+	requote(o)
+	if err := s.requoteCharge(ctx, o, previous); err != nil {
 		return nil, err
 	}
 
@@ -185,6 +355,7 @@ func (s *OrderService) FindByID(ctx context.Context, id string) (_ *order.Order,
 	if ord.Driver != "" && usr.Role == order.RoleDriver && ord.Driver != usr.ID {
 		return nil, order.NewError(order.ErrAccessDenied, 401, "user not provided")
 	}
+	ord.Redact(usr)
 	return ord, nil
 }
 
@@ -193,12 +364,14 @@ func (s *OrderService) FindAll(ctx context.Context, filter order.OrderFilter) (*
 	if err != nil {
 		return nil, err
 	}
+	usr := order.UserFromContext(ctx)
+	for _, t := range trips {
+		t.Redact(usr)
+	}
 	return &order.OrderList{Data: trips, Token: token}, nil
 }
 
 func (s *OrderService) AcceptOrder(ctx context.Context, id string) error {
-	s.orderLock(id)
-	defer s.orderUnlock(id)
 	usr := order.UserFromContext(ctx)
 	if usr == nil {
 		return fmt.Errorf("nil user in context: %w", order.ErrAccessDenied)
@@ -213,56 +386,115 @@ func (s *OrderService) AcceptOrder(ctx context.Context, id string) error {
 	if ord.Driver != "" {
 		return order.ErrOrderAccepted
 	}
-
+	if ord.BannedDrivers[usr.ID] {
+		return fmt.Errorf("driver canceled this order before: %w", order.ErrAccessDenied)
+	}
+	if !ord.Offered(usr.ID, time.Now()) {
+		return fmt.Errorf("order is not offered to the driver: %w", order.ErrAccessDenied)
+	}
+	if err := ord.Transition(order.OrderStatusOnTheWay, s.statusChange(ctx, usr, "")); err != nil {
+		return err
+	}
 	ord.Driver = usr.ID
-	ord.Status = order.OrderStatusOnTheWay
-	if err := updateOrder(ctx, s.db, ord.ID, ord); err != nil {
+	ord.AcceptedAt = time.Now().UTC().Unix()
+	unassigned := bson.E{Key: "driver", Value: bson.D{{Key: "$in", Value: bson.A{"", nil}}}}
+	if err := updateOrder(ctx, s.db, ord, unassigned); err != nil {
+		if errors.Is(err, order.ErrConflict) {
+			return order.ErrOrderAccepted
+		}
 		return err
 	}
 
-	if err := s.redis.Publish(ctx, "order:confirmed", ord); err != nil {
+	if err := s.realtime.SetOnTrip(ctx, usr.ID, true); err != nil {
+		slog.Info("unable to flag driver on trip", "driver", usr.ID, "error", err)
+	}
+	if ord.Dispatch != nil {
+		if err := s.realtime.RecordAccept(ctx, usr.ID); err != nil {
+			slog.Info("unable to record driver accept", "driver", usr.ID, "error", err)
+		}
+	}
+	if err := s.redis.Publish(ctx, order.ChannelOrderConfirmed, ord); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (s *OrderService) CancelOrder(ctx context.Context, id string) error {
-	s.orderLock(id)
-	defer s.orderUnlock(id)
+func (s *OrderService) CancelOrder(ctx context.Context, id, reason string) error {
 	user := order.UserFromContext(ctx)
 	if user == nil {
 		return order.ErrAccessDenied
 	}
-	if user.Role != order.RoleDriver && user.Role != order.RoleAdmin {
-		return order.ErrAccessDenied
-	}
 	ord, err := findOrderById(ctx, s.db, id)
 	if err != nil {
 		return err
 	}
-	ord.Status = order.OrderStatusCancel
-	if user.Role == order.RoleDriver {
-		ord.Status = order.OrderStatusWaitingDriver
+	driver := ord.Driver
+	var chargeFee bool
+	switch user.Role {
+	case order.RoleDriver:
+		if ord.Driver != user.ID {
+			return order.ErrAccessDenied
+		}
+		if err := ord.Transition(order.OrderStatusWaitingDriver, s.statusChange(ctx, user, reason)); err != nil {
+			return err
+		}
 		ord.Driver = ""
+		ord.AcceptedAt = 0
+		ord.ArrivedAt = 0
+		if ord.BannedDrivers == nil {
+			ord.BannedDrivers = make(map[string]bool)
+		}
 		ord.BannedDrivers[user.ID] = true
+		// the order is offered again with a fresh deadline
+		ord.Dispatch = nil
+	case order.RoleRider:
+		if ord.Rider != user.ID {
+			return order.ErrAccessDenied
+		}
+		fee := ord.CancellationFeeAt(time.Now().UTC())
+		if err := ord.Transition(order.OrderStatusCancel, s.statusChange(ctx, user, reason)); err != nil {
+			return err
+		}
+		chargeFee = ord.ChargeCancellation(fee)
+	case order.RoleAdmin:
+		if err := ord.Transition(order.OrderStatusCancel, s.statusChange(ctx, user, reason)); err != nil {
+			return err
+		}
+	default:
+		return order.ErrAccessDenied
+	}
+	release := ord.Status == order.OrderStatusCancel && ord.CouponRedeemed
+	if release {
+		ord.CouponRedeemed = false
 	}
 
-	if err = updateOrder(ctx, s.db, ord.ID, ord); err != nil {
+	if err = updateOrder(ctx, s.db, ord); err != nil {
 		return err
 	}
-	if ord.Status == order.OrderStatusWaitingDriver {
-		if err := s.redis.Publish(ctx, "orders", ord); err != nil {
-			return err
+	if release {
+		releaseCoupon(ctx, s.db, ord.Coupon, ord.Rider)
+	}
+	switch {
+	case chargeFee:
+		// failed charges of the fee are retried with the other payments
+		if err := s.payments.Pay(ctx, ord, time.Now()); err != nil {
+			slog.Info("unable to charge cancellation fee", "order", ord.ID, "error", err)
 		}
+	case ord.Status == order.OrderStatusCancel:
+		voidCharge(ctx, s.payments.charger, ord)
 	}
-	return nil
+	if driver != "" {
+		s.releaseDriver(ctx, driver)
+	}
+	if ord.Status == order.OrderStatusWaitingDriver {
+		return s.redis.Publish(ctx, order.ChannelOrders, ord)
+	}
+	return s.redis.Publish(ctx, order.ChannelOrderUpdated, ord)
 }
 
 func (s *OrderService) FinishOrder(ctx context.Context, id string) (err error) {
 	defer derrors.Wrap(&err, "mongo.OrderService.FinishOrder")
-	s.orderLock(id)
-	defer s.orderUnlock(id)
 	user := order.UserFromContext(ctx)
 	if user == nil {
 		return order.ErrAccessDenied
@@ -274,39 +506,68 @@ func (s *OrderService) FinishOrder(ctx context.Context, id string) (err error) {
 	if err != nil {
 		return err
 	}
-	ord.Status = order.OrderStatusDropOff
+	if user.Role == order.RoleDriver && ord.Driver != user.ID {
+		return order.ErrAccessDenied
+	}
+	if ord.Delivery != nil && !ord.Delivery.Delivered() {
+		return fmt.Errorf("order %s has no proof of delivery: %w", ord.ID, order.ErrConflict)
+	}
+	if err := ord.Transition(order.OrderStatusDropOff, s.statusChange(ctx, user, "")); err != nil {
+		return err
+	}
 	ord.EndAt = time.Now().UTC().Unix()
-	if err = updateOrder(ctx, s.db, ord.ID, ord); err != nil {
-		return err
-	}
-	// Create order charge
-	switch ord.ChargeMethod {
-	case order.ChargeMethodCash,
-		order.ChargeMethodMLCTransaction,
-		order.ChargeMethodCUPTransaction,
-		order.ChargeMethodBalance:
-		//TODO: create charge
-		// charger, err := s.charge.Charge(ctx, order.ChargeMethod, currency.Amount{
-		// 	Amount:   int64(order.Price),
-		// 	Currency: currency.MustParse(order.Currency),
-		// })
-		// if err != nil {
-		// 	return nil, err
-		// }
-		// ord.ChargeID = charger.ID
-	default:
-		return fmt.Errorf("unsupported charge method: %s", ord.ChargeMethod)
+	ord.SkipPendingStops()
+	ord.FinalPrice = ord.FinalFare()
+	ord.Price = ord.FinalPrice
+	// The charge is retried later if the attempt below does not finish.
+	ord.ChargeStatus = order.ChargeStatusPending
+	ord.ChargeRetryAt = time.Now().Add(order.ChargeRetryDelay).Unix()
+	if err = updateOrder(ctx, s.db, ord); err != nil {
+		return err
+	}
+	s.releaseDriver(ctx, ord.Driver)
+	// The ride is finished even if the charge or the settlement fails, they
+	// are retried in the background.
+	if err := s.payments.Pay(ctx, ord, time.Now()); err != nil {
+		slog.Info("unable to pay order", "order", ord.ID, "error", err)
+	}
+	if err := s.redis.Publish(ctx, order.ChannelOrderUpdated, ord); err != nil {
+		slog.Info("unable to publish order update", "order", ord.ID, "error", err)
 	}
 
 	// TODO: send notification to rider that driver started the ride
-	// TODO: update rider last location in the trip
 
 	return nil
 }
 
+// DriverArrived implements order.OrderService.
+func (s *OrderService) DriverArrived(ctx context.Context, id string) (err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.DriverArrived")
+	user := order.UserFromContext(ctx)
+	if user == nil {
+		return order.ErrAccessDenied
+	}
+	if user.Role != order.RoleDriver && user.Role != order.RoleAdmin {
+		return order.ErrAccessDenied
+	}
+	ord, err := findOrderById(ctx, s.db, id)
+	if err != nil {
+		return err
+	}
+	if user.Role == order.RoleDriver && ord.Driver != user.ID {
+		return order.ErrAccessDenied
+	}
+	if err := ord.Transition(order.OrderStatusArrived, s.statusChange(ctx, user, "")); err != nil {
+		return err
+	}
+	ord.ArrivedAt = time.Now().UTC().Unix()
+	if err := updateOrder(ctx, s.db, ord); err != nil {
+		return err
+	}
+	return s.redis.Publish(ctx, order.ChannelOrderUpdated, ord)
+}
+
 func (s *OrderService) StartOrder(ctx context.Context, id string) error {
-	s.orderLock(id)
-	defer s.orderUnlock(id)
 	user := order.UserFromContext(ctx)
 	if user == nil {
 		return order.ErrAccessDenied
@@ -318,15 +579,66 @@ func (s *OrderService) StartOrder(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	if ord.Driver != user.ID {
+	if user.Role == order.RoleDriver && ord.Driver != user.ID {
 		return order.ErrAccessDenied
 	}
-	ord.Status = order.OrderStatusPickUp
-	ord.StartAt = time.Now().UTC().Unix()
-	if err = updateOrder(ctx, s.db, ord.ID, ord); err != nil {
+	if err := ord.Transition(order.OrderStatusPickUp, s.statusChange(ctx, user, "")); err != nil {
 		return err
 	}
-	return nil
+	now := time.Now().UTC()
+	ord.StartAt = now.Unix()
+	ord.WaitMinutes, ord.WaitCharge = ord.BillableWait(now)
+	ord.Price += ord.WaitCharge
+	if err = updateOrder(ctx, s.db, ord); err != nil {
+		return err
+	}
+	return s.redis.Publish(ctx, order.ChannelOrderUpdated, ord)
+}
+
+// Track implements order.OrderService.
+func (s *OrderService) Track(ctx context.Context, id string, points []*order.Point) (err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.Track")
+	user := order.UserFromContext(ctx)
+	if user == nil || user.Role != order.RoleDriver {
+		return order.ErrAccessDenied
+	}
+	if len(points) == 0 {
+		return order.NewMissingParameter("points")
+	}
+	// Batches can race with other order updates, retry on version conflicts.
+	for attempt := 0; attempt < 3; attempt++ {
+		ord, err := findOrderById(ctx, s.db, id)
+		if err != nil {
+			return err
+		}
+		if ord.Driver != user.ID {
+			return order.ErrAccessDenied
+		}
+		if ord.Status != order.OrderStatusPickUp {
+			return fmt.Errorf("order %s is %s: %w", ord.ID, ord.Status, order.ErrInvalidTransition)
+		}
+		if ord.Track(points, time.Now().UTC()) == 0 {
+			return nil
+		}
+		err = updateOrder(ctx, s.db, ord)
+		if errors.Is(err, order.ErrConflict) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		last := ord.History[len(ord.History)-1]
+		if err := s.realtime.UpdateLocation(ctx, user.ID, order.GeoLocation{
+			Type:        "Point",
+			Coordinates: []float64{last.Lng, last.Lat},
+			Lat:         last.Lat,
+			Long:        last.Lng,
+		}); err != nil {
+			slog.Info("unable to update driver location", "order", ord.ID, "error", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("order %s is being updated: %w", id, order.ErrConflict)
 }
 
 // RateOrder implements order.OrderService.
@@ -344,12 +656,357 @@ func (s *OrderService) RateOrder(ctx context.Context, id string, rate float64, c
 	}
 	o.Rate = rate
 	o.Review = comment
-	if err := updateOrder(ctx, s.db, o.ID, o); err != nil {
+	if err := updateOrder(ctx, s.db, o); err != nil {
 		return err
 	}
 	return nil
 }
 
+// StatusHistory implements order.OrderService.
+func (s *OrderService) StatusHistory(ctx context.Context, id string, filter order.StatusHistoryFilter) (_ *order.StatusHistoryList, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.StatusHistory")
+	usr := order.UserFromContext(ctx)
+	if usr == nil {
+		return nil, order.ErrAccessDenied
+	}
+	ord, err := findOrderById(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if usr.Role == order.RoleDriver && ord.Driver != usr.ID {
+		return nil, order.ErrAccessDenied
+	}
+	return ord.Timeline(filter), nil
+}
+
+// statusChange builds the audit context of a transition. The driver position
+// is taken from the realtime index when available.
+func (s *OrderService) statusChange(ctx context.Context, usr *order.User, reason string) order.StatusChange {
+	change := order.StatusChange{
+		Actor:  usr,
+		Reason: reason,
+	}
+	if usr.Role == order.RoleDriver {
+		if location, err := s.realtime.Location(ctx, usr.ID); err == nil {
+			change.Location = location
+		}
+	}
+	return change
+}
+
+// Updates implements order.OrderService.
+func (s *OrderService) Updates(ctx context.Context, id string) (_ <-chan *order.Order, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.Updates")
+	usr := order.UserFromContext(ctx)
+	if usr == nil {
+		return nil, order.ErrAccessDenied
+	}
+	load := func() (*order.Order, error) {
+		ord, err := findOrderById(ctx, s.db, id)
+		if err != nil {
+			return nil, err
+		}
+		if !ord.VisibleTo(usr) {
+			return nil, order.ErrAccessDenied
+		}
+		return ord, nil
+	}
+	return s.watch(ctx, usr, true, load, func(o *order.Order) bool {
+		return o.ID == id && o.VisibleTo(usr)
+	})
+}
+
+// ActiveOrderUpdates implements order.OrderService.
+func (s *OrderService) ActiveOrderUpdates(ctx context.Context) (_ <-chan *order.Order, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.ActiveOrderUpdates")
+	usr := order.UserFromContext(ctx)
+	if usr == nil {
+		return nil, order.ErrAccessDenied
+	}
+	load := func() (*order.Order, error) {
+		active, err := findActiveOrder(ctx, s.db, usr)
+		if errors.Is(err, order.ErrNotFound) {
+			return nil, nil
+		}
+		return active, err
+	}
+	return s.watch(ctx, usr, false, load, func(o *order.Order) bool {
+		return o.Rider == usr.ID || o.Driver == usr.ID
+	})
+}
+
+// StopArrived implements order.OrderService.
+func (s *OrderService) StopArrived(ctx context.Context, id, stop string) (err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.StopArrived")
+	return s.updateTrip(ctx, id, func(ord *order.Order) error {
+		return ord.StopArrived(stop, time.Now().UTC())
+	})
+}
+
+// StopDeparted implements order.OrderService.
+func (s *OrderService) StopDeparted(ctx context.Context, id, stop string) (err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.StopDeparted")
+	return s.updateTrip(ctx, id, func(ord *order.Order) error {
+		return ord.StopDeparted(stop, time.Now().UTC())
+	})
+}
+
+// updateTrip applies an event of the assigned driver on the trip to the order.
+func (s *OrderService) updateTrip(ctx context.Context, id string, event func(*order.Order) error) error {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleDriver {
+		return order.ErrAccessDenied
+	}
+	ord, err := findOrderById(ctx, s.db, id)
+	if err != nil {
+		return err
+	}
+	if ord.Driver != usr.ID {
+		return order.ErrAccessDenied
+	}
+	if err := event(ord); err != nil {
+		return err
+	}
+	if err := updateOrder(ctx, s.db, ord); err != nil {
+		return err
+	}
+	return s.redis.Publish(ctx, order.ChannelOrderUpdated, ord)
+}
+
+// AddStop implements order.OrderService.
+func (s *OrderService) AddStop(ctx context.Context, id string, point order.Point, position int) (_ *order.Order, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.AddStop")
+	return s.updateStops(ctx, id, func(ord *order.Order) error {
+		return ord.AddStop(&point, position)
+	})
+}
+
+// RemoveStop implements order.OrderService.
+func (s *OrderService) RemoveStop(ctx context.Context, id, stop string) (_ *order.Order, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.RemoveStop")
+	return s.updateStops(ctx, id, func(ord *order.Order) error {
+		return ord.RemoveStop(stop)
+	})
+}
+
+// updateStops applies a change of the stops requested by the rider, routing
+// and pricing the order again.
+func (s *OrderService) updateStops(ctx context.Context, id string, change func(*order.Order) error) (*order.Order, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleRider {
+		return nil, order.ErrAccessDenied
+	}
+	ord, err := findOrderById(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if ord.Rider != usr.ID {
+		return nil, order.ErrAccessDenied
+	}
+	previous := ord.Price
+	if err := change(ord); err != nil {
+		return nil, err
+	}
+	ord, err = s.prepareOrder(ctx, ord, order.DirectionRequest{
+		Points:   ord.Item.Points,
+		Riders:   ord.Item.Riders,
+		Baggages: ord.Item.Baggages,
+		Coupon:   ord.Item.Coupon,
+		Currency: ord.Item.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	requote(ord)
+	if err := s.requoteCharge(ctx, ord, previous); err != nil {
+		return nil, err
+	}
+	if err := s.redis.Publish(ctx, order.ChannelOrderUpdated, ord); err != nil {
+		slog.Info("unable to publish order update", "order", ord.ID, "error", err)
+	}
+	return ord, nil
+}
+
+// ProveDelivery implements order.OrderService.
+func (s *OrderService) ProveDelivery(ctx context.Context, id string, proof order.DeliveryProof, otp string) (err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.ProveDelivery")
+	var proveErr error
+	err = s.updateTrip(ctx, id, func(ord *order.Order) error {
+		proveErr = ord.ProveDelivery(proof, otp, time.Now().UTC())
+		// the wrong codes are stored, so the code cannot be guessed
+		if errors.Is(proveErr, order.ErrInvalidOTP) || errors.Is(proveErr, order.ErrLocked) {
+			return nil
+		}
+		return proveErr
+	})
+	if err != nil {
+		return err
+	}
+	return proveErr
+}
+
+// TrackDelivery implements order.OrderService.
+func (s *OrderService) TrackDelivery(ctx context.Context, token string) (_ *order.DeliveryTracking, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.TrackDelivery")
+	if token == "" {
+		return nil, order.NewMissingParameter("token")
+	}
+	var ord order.Order
+	err = s.db.Collection(OrderCollection).FindOne(ctx, bson.D{{Key: "delivery.tracking_token", Value: token}}).Decode(&ord)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("delivery: %w", order.ErrNotFound)
+		}
+		return nil, fmt.Errorf("unable to find delivery: %v: %w", err, order.ErrInternal)
+	}
+	var location *order.Point
+	if ord.Status.HasDriver() {
+		location, err = s.realtime.Location(ctx, ord.Driver)
+		if err != nil && !errors.Is(err, order.ErrNotFound) {
+			return nil, err
+		}
+	}
+	return ord.Tracking(location, time.Now()), nil
+}
+
+// releaseDriver makes the driver available for other orders.
+func (s *OrderService) releaseDriver(ctx context.Context, driver string) {
+	if err := s.realtime.SetOnTrip(ctx, driver, false); err != nil {
+		slog.Info("unable to release driver", "driver", driver, "error", err)
+	}
+}
+
+// positionInterval is how often the driver position is read from the
+// realtime index while streaming it to the rider.
+const positionInterval = 3 * time.Second
+
+// DriverPositions implements order.OrderService.
+func (s *OrderService) DriverPositions(ctx context.Context, id string) (_ <-chan *order.DriverPosition, err error) {
+	defer derrors.Wrap(&err, "mongo.OrderService.DriverPositions")
+	usr := order.UserFromContext(ctx)
+	if usr == nil {
+		return nil, order.ErrAccessDenied
+	}
+	if usr.Role != order.RoleRider {
+		return nil, order.ErrAccessDenied
+	}
+	ord, err := findOrderById(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if ord.Rider != usr.ID {
+		return nil, order.ErrAccessDenied
+	}
+	if !ord.Status.HasDriver() {
+		return nil, fmt.Errorf("order %s has no driver assigned: %w", id, order.ErrConflict)
+	}
+
+	load := func() (*order.Order, error) {
+		return ord, nil
+	}
+	updates, err := s.watch(ctx, usr, true, load, func(o *order.Order) bool {
+		return o.ID == ord.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *order.DriverPosition, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(positionInterval)
+		defer ticker.Stop()
+		var last *order.Point
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case o, ok := <-updates:
+				if !ok || !o.Status.HasDriver() || o.Driver != ord.Driver {
+					return
+				}
+				ord = o
+				continue
+			case <-ticker.C:
+			}
+			location, err := s.realtime.Location(ctx, ord.Driver)
+			if err != nil {
+				if !errors.Is(err, order.ErrNotFound) {
+					slog.Info("unable to get driver location", "driver", ord.Driver, "error", err)
+				}
+				continue
+			}
+			if last != nil && last.Lat == location.Lat && last.Lng == location.Lng {
+				continue
+			}
+			last = location
+			select {
+			case ch <- ord.DriverPosition(location, time.Now()):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// watch sends the current order returned by load, if any, and then every
+// published order matching the filter. The updates are subscribed before
+// loading the order, so none published in between is lost. Watches of a
+// single order end with its terminal status, the others with the context.
+// The orders are redacted for the user watching them.
+func (s *OrderService) watch(ctx context.Context, usr *order.User, single bool, load func() (*order.Order, error), match func(*order.Order) bool) (<-chan *order.Order, error) {
+	pubsub, err := s.redis.SubscribeActive(ctx, order.ChannelOrders, order.ChannelOrderConfirmed, order.ChannelOrderUpdated)
+	if err != nil {
+		return nil, err
+	}
+	current, err := load()
+	if err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	ch := make(chan *order.Order, 1)
+	go func() {
+		defer close(ch)
+		defer pubsub.Close()
+		if current != nil {
+			current.Redact(usr)
+			ch <- current
+			if single && current.Status.IsTerminal() {
+				return
+			}
+		}
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var o order.Order
+				if err := json.Unmarshal([]byte(msg.Payload), &o); err != nil {
+					slog.Info("unable to decode order update", "error", err)
+					continue
+				}
+				if !match(&o) {
+					continue
+				}
+				o.Redact(usr)
+				select {
+				case ch <- &o:
+				case <-ctx.Done():
+					return
+				}
+				if single && o.Status.IsTerminal() {
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
 // Categories implements order.OrderService.
 func (s *OrderService) Categories(ctx context.Context, id string) ([]*order.CategoryPrice, error) {
 	o, err := findOrderById(ctx, s.db, id)
@@ -422,6 +1079,34 @@ func findOrders(ctx context.Context, db *DB, filter order.OrderFilter) ([]*order
 	return trips, token, nil
 }
 
+// findActiveOrder returns the latest order of the user that is confirmed and
+// not finished yet.
+func findActiveOrder(ctx context.Context, db *DB, usr *order.User) (*order.Order, error) {
+	active := []order.OrderStatus{
+		order.OrderStatusConfirmed,
+		order.OrderStatusWaitingDriver,
+		order.OrderStatusOnTheWay,
+		order.OrderStatusArrived,
+		order.OrderStatusPickUp,
+	}
+	f := bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "rider", Value: usr.ID}},
+			bson.D{{Key: "driver", Value: usr.ID}},
+		}},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: active}}},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "_id", Value: -1}})
+	var o order.Order
+	if err := db.Collection(OrderCollection).FindOne(ctx, f, opts).Decode(&o); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, order.ErrNotFound
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
 func findOrderById(ctx context.Context, db *DB, id string) (*order.Order, error) {
 	usr := order.UserFromContext(ctx)
 	if usr == nil {
@@ -444,12 +1129,31 @@ func findOrderById(ctx context.Context, db *DB, id string) (*order.Order, error)
 	return trips[0], nil
 }
 
-func updateOrder(ctx context.Context, db *DB, id string, o *order.Order) error {
+// updateOrder stores the order only if nobody else modified it since it was
+// read. Every write bumps the order version, so concurrent writers on any
+// replica are detected and rejected with order.ErrConflict. Extra conditions
+// narrow the compare-and-swap further.
+func updateOrder(ctx context.Context, db *DB, o *order.Order, conditions ...bson.E) error {
+	version := o.Version
+	versionFilter := bson.E{Key: "version", Value: version}
+	if version == 0 {
+		// orders stored before versioning have no version field
+		versionFilter.Value = bson.D{{Key: "$in", Value: bson.A{0, nil}}}
+	}
+	filter := append(bson.D{{Key: "_id", Value: o.ID}, versionFilter}, conditions...)
+
+	o.Version++
 	o.UpdatedAt = time.Now().UTC().Unix()
-	collection := db.client.Database(database).Collection(OrderCollection.String())
-	if _, err := collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: id}}, bson.D{{Key: "$set", Value: o}}); err != nil {
+	collection := db.Collection(OrderCollection)
+	res, err := collection.ReplaceOne(ctx, filter, o)
+	if err != nil {
+		o.Version = version
 		return fmt.Errorf("unabe to update the order: %v: %w", err, order.ErrInternal)
 	}
+	if res.MatchedCount == 0 {
+		o.Version = version
+		return fmt.Errorf("order %s was modified concurrently: %w", o.ID, order.ErrConflict)
+	}
 	return nil
 }
 
@@ -461,27 +1165,6 @@ func storeOrder(ctx context.Context, db *DB, o *order.Order) error {
 	return nil
 }
 
-func (s *OrderService) orderLock(key string) {
-	mu := sync.Mutex{}
-	l_, _ := s.mutex.LoadOrStore(key, &mu)
-	l := l_.(*sync.Mutex)
-	l.Lock()
-	if l != &mu {
-		l.Unlock()
-		s.orderLock(key)
-	}
-}
-
-func (s *OrderService) orderUnlock(key string) {
-	l_, ok := s.mutex.Load(key)
-	if !ok {
-		return
-	}
-	l := l_.(*sync.Mutex)
-	s.mutex.Delete(key)
-	l.Unlock()
-}
-
 func (s *OrderService) prepareOrder(ctx context.Context, o *order.Order, req order.DirectionRequest) (*order.Order, error) {
 	if o == nil {
 		o = &order.Order{
@@ -520,6 +1203,9 @@ func (s *OrderService) prepareOrder(ctx context.Context, o *order.Order, req ord
 		Coupon:   req.Coupon,
 		Currency: req.Currency,
 	}
+	if err := s.matchZones(ctx, o, req.Points); err != nil {
+		return nil, err
+	}
 	routes, strBody, err := s.direction.GetRoute(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get the route: %w", err)
@@ -533,63 +1219,98 @@ func (s *OrderService) prepareOrder(ctx context.Context, o *order.Order, req ord
 	o.Distance = o.Route.Distance
 	o.Duration = o.Route.Duration
 	o.RouteString = base64.StdEncoding.EncodeToString([]byte(strBody))
+	o.SyncStops()
 
 	err = s.CalculatePrice(o)
 	if err != nil {
 		return nil, fmt.Errorf("unable to calculate the price: %w", err)
 	}
+	if err := s.applyCoupon(ctx, o); err != nil {
+		return nil, err
+	}
 	return o, nil
 }
 
-func checkRate(r *order.Rate) bool {
-	currentTime := time.Now().UTC()
-	if r.StartDate != "" && r.EndDate != "" {
-		startDate, err := time.Parse("2006-01-02", r.StartDate)
-		if err != nil {
-			return false
-		}
-		endDate, err := time.Parse("2006-01-02", r.EndDate)
-		if err != nil {
-			return false
+// applyCoupon discounts the category prices with the coupon of the order.
+// The coupon of a confirmed order cannot change and its limits were already
+// checked when it was redeemed.
+func (s *OrderService) applyCoupon(ctx context.Context, o *order.Order) error {
+	code := order.NormalizeCouponCode(o.Item.Coupon)
+	if o.CouponRedeemed {
+		code = o.Coupon
+	}
+	if code == "" {
+		o.ApplyCoupon(nil)
+		return nil
+	}
+	coupon, err := findCouponByCode(ctx, s.db, code)
+	if errors.Is(err, order.ErrNotFound) {
+		return order.NewInvalidParameter("coupon", code)
+	}
+	if err != nil {
+		return err
+	}
+	if !o.CouponRedeemed {
+		if err := s.checkCoupon(ctx, coupon, o); err != nil {
+			return err
 		}
+	}
+	o.ApplyCoupon(coupon)
+	return nil
+}
 
-		if (currentTime.Unix() < startDate.Unix() || currentTime.Unix() > startDate.Unix()) ||
-			(r.EndDate != "" && currentTime.Unix() > endDate.Unix() || r.StartDate < r.EndDate) {
-			return false
+// checkCoupon reports why the rider cannot redeem the coupon on the order
+// now, if so.
+func (s *OrderService) checkCoupon(ctx context.Context, coupon *order.Coupon, o *order.Order) error {
+	var rides int
+	if coupon.FirstRideOnly {
+		var err error
+		if rides, err = countRides(ctx, s.db, o.Rider, o.ID); err != nil {
+			return err
 		}
 	}
-	today := currentTime.Format("2006-01-02")
-	if r.StartTime != "" {
-		rateStartTime, err := time.Parse("2006-01-02 15:04", fmt.Sprintf("%s %s", today, r.StartTime))
-		if err != nil {
-			return false
-		}
-		if currentTime.Before(rateStartTime) {
-			return false
-		}
+	return coupon.Check(o.Rider, rides, time.Now())
+}
+
+// requote updates the price of the selected category after the route
+// changed. A route changed by the rider after confirming is a new quote, the
+// final fare tolerance applies around it.
+func requote(o *order.Order) {
+	if o.SelectedCategory == nil {
+		return
 	}
-	if r.EndTime != "" {
-		rateEndTime, err := time.Parse("2006-01-02 15:04", fmt.Sprintf("%s %s", today, r.EndTime))
-		if err != nil {
-			return false
-		}
-		if currentTime.After(rateEndTime) {
-			return false
+	for _, c := range o.CategoryPrice {
+		if c.Category == o.SelectedCategory.Category {
+			o.SelectedCategory = c
+			o.QuotedPrice = c.Price + c.Discount
+			o.Discount = c.Discount
+			o.Price = c.Price + o.WaitCharge
+			return
 		}
 	}
-	return true
 }
 
-func price(distance, duration float64, rate order.Rate, riders int) float64 {
-	price := float64(rate.BasePrice) +
-		float64(rate.PricePerKm)*(float64(distance)/1000) +
-		float64(rate.PricePerMin)*float64(duration)/60 +
-		float64(rate.PricePerPassenger)*float64(riders)
-	if rate.PricePerBaggage != 0 {
-		price += float64(rate.PricePerBaggage)
+// requoteCharge stores the requoted order and moves the held price of
+// confirmed orders to the new price. The previous price is held again when
+// the order cannot be stored.
+func (s *OrderService) requoteCharge(ctx context.Context, ord *order.Order, previous int) error {
+	if ord.Price == previous || !ord.PriceHeld() {
+		return updateOrder(ctx, s.db, ord)
+	}
+	if err := reauthorizeCharge(ctx, s.payments.charger, ord, previous); err != nil {
+		return err
 	}
-	if rate.PricePerCarryPet != 0 {
-		price += float64(rate.PricePerCarryPet)
+	if err := updateOrder(ctx, s.db, ord); err != nil {
+		restore := *ord
+		restore.Price = previous
+		if err := reauthorizeCharge(ctx, s.payments.charger, &restore, ord.Price); err != nil {
+			slog.Info("unable to hold the previous price", "order", ord.ID, "error", err)
+		}
+		return err
 	}
-	return price
+	return nil
+}
+
+func price(distance, duration float64, rate order.Rate, riders int) float64 {
+	return rate.Price(distance, duration, riders)
 }
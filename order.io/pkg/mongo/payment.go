@@ -0,0 +1,223 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"order.io/pkg/order"
+	"order.io/pkg/redis"
+)
+
+// Payments charges the finished orders and settles their fares with the
+// drivers, and retries the charges and settlements that failed or were
+// interrupted.
+type Payments struct {
+	db       *DB
+	redis    *redis.Redis
+	charger  order.Charger
+	settler  order.Settler
+	policy   order.SettlementPolicy
+	interval time.Duration
+}
+
+// NewPayments returns the payments of the orders. The settlements are skipped
+// when the settler is nil.
+func NewPayments(db *DB, rdb *redis.Redis, charger order.Charger, settler order.Settler, policy order.SettlementPolicy) *Payments {
+	return &Payments{
+		db:       db,
+		redis:    rdb,
+		charger:  charger,
+		settler:  settler,
+		policy:   policy,
+		interval: 30 * time.Second,
+	}
+}
+
+// Run retries the due charges and settlements every interval until the
+// context is done.
+func (p *Payments) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			orders, err := findDuePayments(ctx, p.db, now)
+			if err != nil {
+				slog.Info("unable to find due payments", "error", err)
+				continue
+			}
+			for _, ord := range orders {
+				if err := p.Pay(ctx, ord, now); err != nil {
+					if !errors.Is(err, order.ErrConflict) {
+						slog.Info("unable to pay order", "order", ord.ID, "error", err)
+					}
+					continue
+				}
+				if err := p.redis.Publish(ctx, order.ChannelOrderUpdated, ord); err != nil {
+					slog.Info("unable to publish order update", "order", ord.ID, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// Pay tries the charge of the finished order, or of the cancellation fee of
+// the canceled one, then the settlement of its fare once paid, and stores the
+// results. The error is only about storing them, a failed charge or
+// settlement is recorded on the order.
+func (p *Payments) Pay(ctx context.Context, ord *order.Order, now time.Time) error {
+	if ord.Status != order.OrderStatusDropOff && ord.Status != order.OrderStatusCancel {
+		return nil
+	}
+	if ord.ChargeStatus == order.ChargeStatusPending {
+		charge, err := p.charger.Charge(ctx, ord.ChargeRequest())
+		ord.RecordCharge(charge, err, now)
+		if err != nil {
+			slog.Info("charge failed", "order", ord.ID, "method", ord.ChargeMethod, "attempts", ord.ChargeAttempts, "error", err)
+		}
+		if err := updateOrder(ctx, p.db, ord); err != nil {
+			return err
+		}
+	}
+	if p.settler == nil || !ord.Settleable() {
+		return nil
+	}
+	if ord.Settlement == nil {
+		rate, err := p.commissionRate(ctx, ord)
+		if err != nil {
+			return err
+		}
+		ord.Settle(rate)
+	}
+	if ord.Settlement.Status != order.SettlementStatusPending {
+		return nil
+	}
+	id, err := p.settler.Settle(ctx, ord.SettlementRequest())
+	ord.RecordSettlement(id, err, now)
+	if err != nil {
+		slog.Info("settlement failed", "order", ord.ID, "driver", ord.Driver, "attempts", ord.Settlement.Attempts, "error", err)
+	}
+	return updateOrder(ctx, p.db, ord)
+}
+
+// commissionRate returns the commission of the zone of the pickup point, or
+// of the category of the order, or the default one.
+func (p *Payments) commissionRate(ctx context.Context, ord *order.Order) (float64, error) {
+	var zone, category float64
+	if len(ord.Item.Points) > 0 {
+		zones, _, err := findZones(ctx, p.db, order.ZoneFilter{})
+		if err != nil {
+			return 0, err
+		}
+		zone = order.ZoneCommission(zones, ord.Item.Points[0])
+	}
+	if ord.SelectedCategory != nil {
+		rate, err := findVehicleCategoryRateByCategory(ctx, p.db, ord.SelectedCategory.Category)
+		switch {
+		case err == nil:
+			category = rate.Commission
+		case !errors.Is(err, order.ErrNotFound):
+			return 0, err
+		}
+	}
+	return p.policy.CommissionRate(zone, category), nil
+}
+
+// authorizeCharge holds the price of the confirmed order, for the charge
+// methods holding it.
+func authorizeCharge(ctx context.Context, charger order.Charger, ord *order.Order) error {
+	a, ok := charger.(order.Authorizer)
+	if !ok {
+		return nil
+	}
+	if err := a.Authorize(ctx, ord.ChargeRequest()); err != nil {
+		if errors.Is(err, order.ErrChargeDeclined) {
+			return order.NewError(err, http.StatusPaymentRequired, "unable to hold the price of the ride")
+		}
+		if errors.Is(err, order.ErrInvalidInput) {
+			return order.NewError(err, http.StatusBadRequest, "the charge method is not available")
+		}
+		return fmt.Errorf("unable to hold the price: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// reauthorizeCharge holds the new price of an order whose route changed after
+// confirming, in place of the previous price. The previous price is held
+// again when the new one cannot be.
+func reauthorizeCharge(ctx context.Context, charger order.Charger, ord *order.Order, previous int) error {
+	a, ok := charger.(order.Authorizer)
+	if !ok {
+		return nil
+	}
+	held := ord.ChargeRequest()
+	held.Amount = previous
+	if err := a.Void(ctx, held); err != nil {
+		return fmt.Errorf("unable to release the held price: %v: %w", err, order.ErrInternal)
+	}
+	if err := authorizeCharge(ctx, charger, ord); err != nil {
+		if err := a.Authorize(ctx, held); err != nil {
+			slog.Info("unable to hold the previous price", "order", ord.ID, "error", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// voidCharge releases the price held for the canceled order.
+func voidCharge(ctx context.Context, charger order.Charger, ord *order.Order) {
+	a, ok := charger.(order.Authorizer)
+	if !ok {
+		return
+	}
+	if err := a.Void(ctx, ord.ChargeRequest()); err != nil {
+		slog.Info("unable to void charge", "order", ord.ID, "error", err)
+	}
+}
+
+// findDuePayments returns the finished orders with a charge or a settlement
+// due before the given time, and the canceled ones with a cancellation fee
+// to charge. Paid orders without a settlement are due at once.
+func findDuePayments(ctx context.Context, db *DB, now time.Time) ([]*order.Order, error) {
+	paid := bson.D{{Key: "$in", Value: bson.A{order.ChargeStatusSucceeded, order.ChargeStatusReceivable}}}
+	charged := bson.D{{Key: "$in", Value: bson.A{order.OrderStatusDropOff, order.OrderStatusCancel}}}
+	f := bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{
+				{Key: "status", Value: charged},
+				{Key: "charge_status", Value: order.ChargeStatusPending},
+				{Key: "charge_retry_at", Value: bson.D{{Key: "$lte", Value: now.Unix()}}},
+			},
+			bson.D{
+				{Key: "status", Value: order.OrderStatusDropOff},
+				{Key: "charge_status", Value: paid},
+				{Key: "driver", Value: bson.D{{Key: "$exists", Value: true}}},
+				{Key: "settlement", Value: bson.D{{Key: "$exists", Value: false}}},
+			},
+			bson.D{
+				{Key: "status", Value: order.OrderStatusDropOff},
+				{Key: "charge_status", Value: paid},
+				{Key: "settlement.status", Value: order.SettlementStatusPending},
+				{Key: "settlement.retry_at", Value: bson.D{{Key: "$lte", Value: now.Unix()}}},
+			},
+		}},
+	}
+	cur, err := db.Collection(OrderCollection).Find(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find orders: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var orders []*order.Order
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, fmt.Errorf("unable to decode orders: %v: %w", err, order.ErrInternal)
+	}
+	return orders, nil
+}
@@ -0,0 +1,88 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"order.io/pkg/order"
+)
+
+// holds is a charger holding the prices by idempotency key.
+type holds map[string]int
+
+func (h holds) Charge(_ context.Context, req order.ChargeRequest) (*order.Charge, error) {
+	delete(h, req.IdempotencyKey)
+	return &order.Charge{ID: req.IdempotencyKey, Status: order.ChargeStatusSucceeded}, nil
+}
+
+func (h holds) Authorize(_ context.Context, req order.ChargeRequest) error {
+	h[req.IdempotencyKey] = req.Amount
+	return nil
+}
+
+func (h holds) Void(_ context.Context, req order.ChargeRequest) error {
+	delete(h, req.IdempotencyKey)
+	return nil
+}
+
+// straightRoute routes the points with legs of 5 km.
+type straightRoute struct{}
+
+func (straightRoute) GetRoute(_ context.Context, req order.DirectionRequest) (*order.DirectionResponse, string, error) {
+	route := &order.Route{}
+	for i := 1; i < len(req.Points); i++ {
+		route.Legs = append(route.Legs, &order.Legs{Distance: 5000, Duration: 600})
+		route.Distance += 5000
+		route.Duration += 600
+	}
+	return &order.DirectionResponse{Routes: []*order.Route{route}}, "{}", nil
+}
+
+func TestOrderServiceAddStopHoldsTheNewPrice(t *testing.T) {
+	db := NewTestDB()
+	cache := NewTestRedis()
+	defer func() {
+		db.Collection(OrderCollection).Drop(context.Background())
+		db.Collection(VehicleCategoryRateCollection).Drop(context.Background())
+		db.client.Disconnect(context.Background())
+		cache.Close()
+	}()
+	category := &order.VehicleCategoryRate{ID: order.NewID().String(), Category: order.VehicleCategoryX, Factor: 1}
+	if err := insertVehicleCategoryRate(context.Background(), db, category); err != nil {
+		t.Fatal(err)
+	}
+	held := holds{}
+	chargers := order.Chargers{order.ChargeMethodBalance: held}
+	s := NewOrderService(db, cache, order.DefaultSurgePolicy, NewPayments(db, cache, chargers, nil, order.DefaultSettlementPolicy))
+	s.direction = straightRoute{}
+
+	rider := prepareContext(t, order.RoleRider)
+	ord := &order.Order{
+		ID:     order.NewID().String(),
+		Rider:  order.UserFromContext(rider).ID,
+		Status: order.OrderStatusConfirmed,
+		Item: order.Item{
+			Points: []*order.Point{{Lat: 23.1136, Lng: -82.3666}, {Lat: 23.1400, Lng: -82.3500}},
+			Riders: 1,
+		},
+		Currency:         "CUP",
+		Price:            6000,
+		QuotedPrice:      6000,
+		ChargeMethod:     order.ChargeMethodBalance,
+		AppliedRate:      &order.Rate{BasePrice: 1000, PricePerKm: 1000},
+		SurgeMultiplier:  1,
+		SelectedCategory: &order.CategoryPrice{Category: order.VehicleCategoryX, Price: 6000, Factor: 1},
+	}
+	if err := storeOrder(rider, db, ord); err != nil {
+		t.Fatal(err)
+	}
+	held[ord.ID] = ord.Price
+
+	updated, err := s.AddStop(rider, ord.ID, order.Point{Lat: 23.1300, Lng: -82.3600}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Price != 11000 || held[ord.ID] != updated.Price {
+		t.Errorf("OrderService.AddStop() price = %d, held %d, want the new price of 11000 held", updated.Price, held[ord.ID])
+	}
+}
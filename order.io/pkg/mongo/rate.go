@@ -35,6 +35,9 @@ func (s *RateService) Create(ctx context.Context, request order.RateRequest) (*o
 	if err := rate.Validate(); err != nil {
 		return nil, err
 	}
+	if err := checkRateOverlap(ctx, s.db, &rate); err != nil {
+		return nil, err
+	}
 	if err := storeRate(ctx, s.db, &rate); err != nil {
 		return nil, err
 	}
@@ -56,6 +59,9 @@ func (s *RateService) Update(ctx context.Context, request *order.RateRequest) (*
 	if err := rate.Validate(); err != nil {
 		return nil, err
 	}
+	if err := checkRateOverlap(ctx, s.db, rate); err != nil {
+		return nil, err
+	}
 	if err := updateRate(ctx, s.db, rate); err != nil {
 		return nil, err
 	}
@@ -86,6 +92,23 @@ func (s *RateService) FindAll(ctx context.Context, request order.RateFilter) ([]
 	return findRates(ctx, s.db, &request)
 }
 
+// checkRateOverlap rejects a rate that may apply to the same rides as
+// another rate at the same priority, since the choice between them would be
+// arbitrary.
+func checkRateOverlap(ctx context.Context, db *DB, rate *order.Rate) error {
+	rates, _, err := findRates(ctx, db, &order.RateFilter{})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, other := range rates {
+		if other.ID != rate.ID && rate.Overlaps(other, now) {
+			return fmt.Errorf("rate %s overlaps rate %s at priority %d: %w", rate.Code, other.Code, rate.Priority, order.ErrConflict)
+		}
+	}
+	return nil
+}
+
 func updateRate(ctx context.Context, db *DB, rate *order.Rate) error {
 	collection := db.client.Database(database).Collection(RatesCollection.String())
 	if _, err := collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: rate.ID}}, bson.D{{Key: "$set", Value: rate}}); err != nil {
@@ -176,24 +199,62 @@ func assembleRate(rate *order.Rate, req order.RateRequest) {
 	if req.BasePrice > 0 {
 		rate.BasePrice = req.BasePrice
 	}
-	rate.PricePerMin = req.PricePerMin
-	rate.PricePerKm = req.PricePerKm
-	rate.PricePerPassenger = req.PricePerPassenger
-	rate.PricePerBaggage = req.PricePerBaggage
+	if req.PricePerMin > 0 {
+		rate.PricePerMin = req.PricePerMin
+	}
+	if req.PricePerKm > 0 {
+		rate.PricePerKm = req.PricePerKm
+	}
+	if req.PricePerPassenger > 0 {
+		rate.PricePerPassenger = req.PricePerPassenger
+	}
+	if req.PricePerBaggage > 0 {
+		rate.PricePerBaggage = req.PricePerBaggage
+	}
 	if len(req.StartTime) > 0 {
 		rate.StartTime = req.StartTime
 	}
 	if len(req.EndTime) > 0 {
 		rate.EndTime = req.EndTime
 	}
+	if req.Priority != nil {
+		rate.Priority = *req.Priority
+	}
+	if req.Days != nil {
+		rate.Days = *req.Days
+	}
+	if req.Timezone != "" {
+		rate.Timezone = req.Timezone
+	}
+	// the dates are days in the timezone of the rate
+	loc, err := time.LoadLocation(rate.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
 	if req.StartDate != 0 {
-		startDate := time.Unix(req.StartDate, 0)
+		startDate := time.Unix(req.StartDate, 0).In(loc)
 		rate.StartDate = startDate.Format("2006-01-02")
 	}
 	if req.EndDate != 0 {
-		endDate := time.Unix(req.EndDate, 0)
+		endDate := time.Unix(req.EndDate, 0).In(loc)
 		rate.EndDate = endDate.Format("2006-01-02")
 	}
-	rate.MinKm = req.MinKm
-	rate.MaxKm = req.MaxKm
+	if req.MinKm > 0 {
+		rate.MinKm = req.MinKm
+	}
+	if req.MaxKm > 0 {
+		rate.MaxKm = req.MaxKm
+	}
+	if req.Cancellation != nil {
+		rate.Cancellation = *req.Cancellation
+	}
+	if req.Waiting != nil {
+		rate.Waiting = *req.Waiting
+	}
+	if req.FareTolerance != nil {
+		rate.FareTolerance = *req.FareTolerance
+	}
+	if req.TaxRate != nil {
+		rate.TaxRate = *req.TaxRate
+	}
 }
@@ -0,0 +1,57 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"order.io/pkg/order"
+)
+
+func TestRateServiceUpdateKeepsOmittedFields(t *testing.T) {
+	ctx := prepareContext(t, order.RoleAdmin)
+
+	database = "test"
+	db := NewTestDB()
+	defer func() {
+		db.client.Database(database).Collection(RatesCollection.String()).Drop(context.Background())
+		db.client.Disconnect(context.Background())
+	}()
+	s := NewRateService(db)
+
+	priority := 2
+	days := []time.Weekday{time.Saturday, time.Sunday}
+	tolerance := 10.0
+	tax := 16.0
+	created, err := s.Create(ctx, order.RateRequest{
+		Code:          "weekend",
+		BasePrice:     1000,
+		PricePerKm:    500,
+		PricePerMin:   50,
+		MaxKm:         40,
+		StartTime:     "08:00",
+		EndTime:       "20:00",
+		Priority:      &priority,
+		Days:          &days,
+		FareTolerance: &tolerance,
+		TaxRate:       &tax,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := s.Update(ctx, &order.RateRequest{
+		ID:         created.ID,
+		PricePerKm: 600,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *created
+	want.PricePerKm = 600
+	if diff := cmp.Diff(&want, updated); diff != "" {
+		t.Errorf("RateService.Update() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"order.io/pkg/order"
+	"order.io/pkg/redis"
+)
+
+// scheduler is the actor recorded in the status history of the scheduled
+// orders it releases to dispatch.
+var scheduler = &order.User{ID: "scheduler", Role: order.RoleAdmin}
+
+// Scheduler reminds the riders of their scheduled rides and releases the
+// rides to dispatch ahead of the pickup time.
+type Scheduler struct {
+	db       *DB
+	redis    *redis.Redis
+	interval time.Duration
+	// DispatchLead is how long before the pickup time a ride is offered to
+	// the drivers.
+	DispatchLead time.Duration
+	// ReminderLead is how long before the pickup time the rider is reminded.
+	ReminderLead time.Duration
+}
+
+func NewScheduler(db *DB, rdb *redis.Redis) *Scheduler {
+	return &Scheduler{
+		db:           db,
+		redis:        rdb,
+		interval:     30 * time.Second,
+		DispatchLead: 15 * time.Minute,
+		ReminderLead: time.Hour,
+	}
+}
+
+// Run checks the scheduled orders every interval until the context is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			orders, err := findScheduledOrders(ctx, s.db, now.Add(max(s.DispatchLead, s.ReminderLead)))
+			if err != nil {
+				slog.Info("unable to find scheduled orders", "error", err)
+				continue
+			}
+			for _, ord := range orders {
+				if err := s.Schedule(ctx, ord, now); err != nil && !errors.Is(err, order.ErrConflict) {
+					slog.Info("unable to schedule order", "order", ord.ID, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// Schedule sends the reminder of the order and releases it to dispatch when
+// they are due.
+func (s *Scheduler) Schedule(ctx context.Context, ord *order.Order, now time.Time) error {
+	remind := ord.DueForReminder(now, s.ReminderLead)
+	dispatch := ord.DueForDispatch(now, s.DispatchLead)
+	if !remind && !dispatch {
+		return nil
+	}
+	if remind {
+		ord.RemindedAt = now.Unix()
+	}
+	if dispatch {
+		change := order.StatusChange{Actor: scheduler, Reason: "scheduled pickup time is near"}
+		if err := ord.Transition(order.OrderStatusConfirmed, change); err != nil {
+			return err
+		}
+	}
+	if err := updateOrder(ctx, s.db, ord); err != nil {
+		return err
+	}
+	if remind {
+		if err := s.redis.Publish(ctx, order.ChannelOrderReminder, ord); err != nil {
+			return err
+		}
+	}
+	if dispatch {
+		return s.redis.Publish(ctx, order.ChannelOrders, ord)
+	}
+	return nil
+}
+
+// findScheduledOrders returns the scheduled orders with a pickup time
+// before the given time.
+func findScheduledOrders(ctx context.Context, db *DB, before time.Time) ([]*order.Order, error) {
+	f := bson.D{
+		{Key: "status", Value: order.OrderStatusScheduled},
+		{Key: "scheduled_at", Value: bson.D{{Key: "$lte", Value: before.Unix()}}},
+	}
+	cur, err := db.Collection(OrderCollection).Find(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find orders: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var orders []*order.Order
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, fmt.Errorf("unable to decode orders: %v: %w", err, order.ErrInternal)
+	}
+	return orders, nil
+}
@@ -0,0 +1,105 @@
+package mongo
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"order.io/pkg/order"
+	"order.io/pkg/redis"
+)
+
+// SurgeUpdater computes the surge multiplier of each cell from the orders
+// waiting for a driver and the available drivers in it.
+type SurgeUpdater struct {
+	db       *DB
+	surge    *redis.SurgeService
+	realtime *redis.RealTimeService
+	policy   order.SurgePolicy
+	interval time.Duration
+}
+
+func NewSurgeUpdater(db *DB, rdb *redis.Redis, policy order.SurgePolicy) *SurgeUpdater {
+	return &SurgeUpdater{
+		db:       db,
+		surge:    redis.NewSurgeService(rdb, policy),
+		realtime: redis.NewRealTimeService(rdb),
+		policy:   policy,
+		interval: 30 * time.Second,
+	}
+}
+
+// Run updates the surge every interval until the context is done.
+func (u *SurgeUpdater) Run(ctx context.Context) {
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := u.Update(ctx, now); err != nil {
+				slog.Info("unable to update surge", "error", err)
+			}
+		}
+	}
+}
+
+// Update moves the multiplier of every cell with demand, or with a surge
+// still decaying, toward the current ratio of orders to drivers.
+func (u *SurgeUpdater) Update(ctx context.Context, now time.Time) error {
+	orders, err := findUndispatchedOrders(ctx, u.db)
+	if err != nil {
+		return err
+	}
+	drivers, err := u.realtime.AvailableDrivers(ctx)
+	if err != nil {
+		return err
+	}
+	previous, err := u.surge.Computed(ctx)
+	if err != nil {
+		return err
+	}
+
+	demand := make(map[string]int)
+	for _, o := range orders {
+		if len(o.Item.Points) > 0 {
+			demand[u.policy.Cell(o.Item.Points[0])]++
+		}
+	}
+	supply := make(map[string]int)
+	for _, p := range drivers {
+		supply[u.policy.Cell(p)]++
+	}
+
+	cells := make(map[string]bool, len(demand)+len(previous))
+	for cell := range demand {
+		cells[cell] = true
+	}
+	for cell := range previous {
+		cells[cell] = true
+	}
+	var surges []*order.Surge
+	var removed []string
+	for cell := range cells {
+		var last float64
+		if p, ok := previous[cell]; ok {
+			last = p.Multiplier
+		}
+		multiplier := u.policy.Next(last, demand[cell], supply[cell])
+		if multiplier <= 1 && demand[cell] == 0 {
+			if _, ok := previous[cell]; ok {
+				removed = append(removed, cell)
+			}
+			continue
+		}
+		surges = append(surges, &order.Surge{
+			Cell:       cell,
+			Multiplier: multiplier,
+			Demand:     demand[cell],
+			Supply:     supply[cell],
+			UpdatedAt:  now.Unix(),
+		})
+	}
+	return u.surge.Store(ctx, surges, removed)
+}
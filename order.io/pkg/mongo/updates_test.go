@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order.io/pkg/order"
+)
+
+func TestOrderServiceUpdatesAccess(t *testing.T) {
+	db := NewTestDB()
+	cache := NewTestRedis()
+	defer func() {
+		db.Collection(OrderCollection).Drop(context.Background())
+		db.client.Disconnect(context.Background())
+		cache.Close()
+	}()
+	s := NewOrderService(db, cache, order.DefaultSurgePolicy, nil)
+
+	rider := prepareContext(t, order.RoleRider)
+	ord := &order.Order{
+		ID:     order.NewID().String(),
+		Rider:  order.UserFromContext(rider).ID,
+		Status: order.OrderStatusConfirmed,
+	}
+	if err := storeOrder(rider, db, ord); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Updates(prepareContext(t, order.RoleRider), ord.ID); !errors.Is(err, order.ErrAccessDenied) {
+		t.Fatalf("OrderService.Updates() by another rider error = %v, want access denied", err)
+	}
+	ctx, cancel := context.WithCancel(rider)
+	defer cancel()
+	updates, err := s.Updates(ctx, ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := <-updates; got == nil || got.ID != ord.ID {
+		t.Fatalf("OrderService.Updates() = %+v, want the order", got)
+	}
+}
+
+func TestOrderServiceUpdatesRedactsDelivery(t *testing.T) {
+	db := NewTestDB()
+	cache := NewTestRedis()
+	defer func() {
+		db.Collection(OrderCollection).Drop(context.Background())
+		db.client.Disconnect(context.Background())
+		cache.Close()
+	}()
+	s := NewOrderService(db, cache, order.DefaultSurgePolicy, nil)
+
+	driver := prepareContext(t, order.RoleDriver)
+	ord := &order.Order{
+		ID:       order.NewID().String(),
+		Rider:    order.NewID().String(),
+		Driver:   order.UserFromContext(driver).ID,
+		Status:   order.OrderStatusPickUp,
+		Delivery: &order.Delivery{RecipientName: "Ana", RecipientPhone: "+5355555555", Size: order.PackageSizeSmall, Weight: 2},
+	}
+	if err := ord.Delivery.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	if err := storeOrder(driver, db, ord); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(driver)
+	defer cancel()
+	updates, err := s.Updates(ctx, ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := <-updates
+	if got == nil || got.Delivery == nil || got.Delivery.TrackingToken != "" {
+		t.Fatalf("OrderService.Updates() to the driver = %+v, want the tracking token hidden", got)
+	}
+}
@@ -47,6 +47,7 @@ func assembleVehicleCategoryRate(rate *order.VehicleCategoryRate, req order.Vehi
 	}
 	rate.Category = req.Category
 	rate.Factor = req.Factor
+	rate.Commission = req.Commission
 }
 
 // Update implements order.VehicleCategoryRateService.
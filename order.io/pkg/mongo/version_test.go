@@ -0,0 +1,51 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order.io/pkg/order"
+)
+
+func TestUpdateOrderConflict(t *testing.T) {
+	ctx := context.Background()
+	db := NewTestDB()
+	defer func() {
+		db.Collection(OrderCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+
+	ord := &order.Order{ID: order.NewID().String(), Status: order.OrderStatusConfirmed, Price: 1000}
+	if err := storeOrder(ctx, db, ord); err != nil {
+		t.Fatal(err)
+	}
+	first, err := findOrderById(ctx, db, ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := findOrderById(ctx, db, ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first.Price = 2000
+	if err := updateOrder(ctx, db, first); err != nil {
+		t.Fatal(err)
+	}
+	second.Price = 3000
+	if err := updateOrder(ctx, db, second); !errors.Is(err, order.ErrConflict) {
+		t.Fatalf("updateOrder() of a stale copy error = %v, want conflict", err)
+	}
+	if second.Version != ord.Version {
+		t.Errorf("updateOrder() left the stale copy at version %d, want %d", second.Version, ord.Version)
+	}
+
+	got, err := findOrderById(ctx, db, ord.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Price != 2000 || got.Version != first.Version {
+		t.Errorf("stored order price = %d version = %d, want the first write with price 2000 version %d", got.Price, got.Version, first.Version)
+	}
+}
@@ -0,0 +1,175 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"order.io/pkg/order"
+)
+
+var _ order.ZoneService = &ZoneService{}
+
+const ZoneCollection Collections = "zones"
+
+type ZoneService struct {
+	db *DB
+}
+
+func NewZoneService(db *DB) *ZoneService {
+	return &ZoneService{
+		db: db,
+	}
+}
+
+// Create implements order.ZoneService.
+func (s *ZoneService) Create(ctx context.Context, req order.ZoneRequest) (*order.Zone, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	var zone order.Zone
+	assembleZone(&zone, req)
+	if err := s.validate(ctx, &zone); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.Collection(ZoneCollection).InsertOne(ctx, &zone); err != nil {
+		return nil, fmt.Errorf("unable to store the zone: %v: %w", err, order.ErrInternal)
+	}
+	return &zone, nil
+}
+
+// Update implements order.ZoneService.
+func (s *ZoneService) Update(ctx context.Context, req order.ZoneRequest) (*order.Zone, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	zone, err := findZoneByID(ctx, s.db, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	assembleZone(zone, req)
+	if err := s.validate(ctx, zone); err != nil {
+		return nil, err
+	}
+	_, err = s.db.Collection(ZoneCollection).ReplaceOne(ctx, bson.D{{Key: "_id", Value: zone.ID}}, zone)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update zone: %v: %w", err, order.ErrInternal)
+	}
+	return zone, nil
+}
+
+// FindByID implements order.ZoneService.
+func (s *ZoneService) FindByID(ctx context.Context, id string) (*order.Zone, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	return findZoneByID(ctx, s.db, id)
+}
+
+// FindAll implements order.ZoneService.
+func (s *ZoneService) FindAll(ctx context.Context, filter order.ZoneFilter) ([]*order.Zone, string, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, "", order.ErrAccessDenied
+	}
+	return findZones(ctx, s.db, filter)
+}
+
+// validate checks the zone and that its rates exist.
+func (s *ZoneService) validate(ctx context.Context, zone *order.Zone) error {
+	if err := zone.Validate(); err != nil {
+		return err
+	}
+	if len(zone.Rates) == 0 {
+		return nil
+	}
+	rates, _, err := findRates(ctx, s.db, &order.RateFilter{Code: zone.Rates})
+	if err != nil {
+		return err
+	}
+	found := make(map[string]bool, len(rates))
+	for _, r := range rates {
+		found[r.Code] = true
+	}
+	for _, code := range zone.Rates {
+		if !found[code] {
+			return order.NewInvalidParameter("rates", code)
+		}
+	}
+	return nil
+}
+
+func findZones(ctx context.Context, db *DB, filter order.ZoneFilter) ([]*order.Zone, string, error) {
+	var zones []*order.Zone
+	var token string
+	f := bson.D{}
+	if len(filter.Ids) > 0 {
+		f = append(f, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: filter.Ids}}})
+	}
+	if len(filter.Type) > 0 {
+		f = append(f, bson.E{Key: "type", Value: bson.D{{Key: "$in", Value: filter.Type}}})
+	}
+	if filter.Token != "" {
+		f = append(f, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: filter.Token}}})
+	}
+	cur, err := db.Collection(ZoneCollection).Find(ctx, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to find zones: %v: %w", err, order.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var zone order.Zone
+		if err := cur.Decode(&zone); err != nil {
+			return nil, "", fmt.Errorf("unable to decode zone: %v: %w", err, order.ErrInternal)
+		}
+		zones = append(zones, &zone)
+		if len(zones) == filter.Limit+1 && filter.Limit > 0 {
+			token = zones[filter.Limit].ID
+			zones = zones[:filter.Limit]
+			break
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, "", fmt.Errorf("unable to iterate over zones: %v: %w", err, order.ErrInternal)
+	}
+	return zones, token, nil
+}
+
+func findZoneByID(ctx context.Context, db *DB, id string) (*order.Zone, error) {
+	zones, _, err := findZones(ctx, db, order.ZoneFilter{Ids: []string{id}, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("unable to find zone: %v: %w", id, order.ErrNotFound)
+	}
+	return zones[0], nil
+}
+
+func assembleZone(zone *order.Zone, req order.ZoneRequest) {
+	if zone.ID == "" {
+		zone.ID = req.ID
+	}
+	if zone.ID == "" {
+		zone.ID = order.NewID().String()
+	}
+	if req.Name != "" {
+		zone.Name = req.Name
+	}
+	if req.Type != "" {
+		zone.Type = req.Type
+	}
+	if len(req.Polygon) > 0 {
+		zone.Polygon = req.Polygon
+	}
+	zone.Rates = req.Rates
+	zone.PickupSurcharge = req.PickupSurcharge
+	zone.DropoffSurcharge = req.DropoffSurcharge
+	zone.Priority = req.Priority
+	zone.Disabled = req.Disabled
+	zone.Commission = req.Commission
+}
@@ -0,0 +1,68 @@
+package order
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// CancellationPolicy defines what a rider pays for canceling a ride once a
+// driver accepted it. It is configured per rate and copied to the order when
+// the price is calculated.
+type CancellationPolicy struct {
+	// FreeWindow is the time in seconds after the driver accepted the order
+	// during which the rider can cancel for free.
+	FreeWindow int64 `json:"free_window,omitempty" bson:"free_window,omitempty"`
+	// Fee is a flat amount charged after the free window.
+	Fee int `json:"fee,omitempty" bson:"fee,omitempty"`
+	// FeePercent is a percentage of the order price charged after the free window.
+	FeePercent float64 `json:"fee_percent,omitempty" bson:"fee_percent,omitempty"`
+}
+
+func (p *CancellationPolicy) Validate() error {
+	if p.FreeWindow < 0 {
+		return fmt.Errorf("cancellation free window must be positive: %w", ErrInvalidInput)
+	}
+	if p.Fee < 0 {
+		return fmt.Errorf("cancellation fee must be positive: %w", ErrInvalidInput)
+	}
+	if p.FeePercent < 0 || p.FeePercent > 100 {
+		return fmt.Errorf("cancellation fee percent must be between 0 and 100: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// CancellationFeeAt returns the amount the rider owes for canceling the order
+// at the given time. Orders without a driver on the way are free to cancel
+// and canceling a started trip costs the fare travelled so far.
+func (o *Order) CancellationFeeAt(now time.Time) int {
+	if o.Status == OrderStatusPickUp {
+		return o.TravelledFare(now)
+	}
+	if o.CancellationPolicy == nil {
+		return 0
+	}
+	switch o.Status {
+	case OrderStatusOnTheWay, OrderStatusArrived:
+	default:
+		return 0
+	}
+	p := o.CancellationPolicy
+	if o.AcceptedAt > 0 && now.Unix()-o.AcceptedAt <= p.FreeWindow {
+		return 0
+	}
+	return p.Fee + int(math.Round(float64(o.Price)*p.FeePercent/100))
+}
+
+// ChargeCancellation records the fee the rider owes for canceling the order
+// and reports whether it is charged. A charged fee leaves the charge pending,
+// so the fee is captured from the held price and the rest is released. The
+// fees of cash rides are only recorded.
+func (o *Order) ChargeCancellation(fee int) bool {
+	o.CancellationFee = fee
+	if fee <= 0 || o.ChargeMethod == ChargeMethodCash {
+		return false
+	}
+	o.ChargeStatus = ChargeStatusPending
+	return true
+}
@@ -0,0 +1,114 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOrderCancellationFeeAt(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	policy := &CancellationPolicy{FreeWindow: 120, Fee: 1000, FeePercent: 10}
+	tests := []struct {
+		name  string
+		order Order
+		want  int
+	}{
+		{
+			name:  "no driver assigned",
+			order: Order{Status: OrderStatusConfirmed, Price: 5000, CancellationPolicy: policy},
+			want:  0,
+		},
+		{
+			name:  "inside the free window",
+			order: Order{Status: OrderStatusOnTheWay, Price: 5000, AcceptedAt: now.Unix() - 60, CancellationPolicy: policy},
+			want:  0,
+		},
+		{
+			name:  "driver on the way after the free window",
+			order: Order{Status: OrderStatusOnTheWay, Price: 5000, AcceptedAt: now.Unix() - 300, CancellationPolicy: policy},
+			want:  1500,
+		},
+		{
+			name: "started trip costs the fare travelled",
+			order: Order{
+				Status:             OrderStatusPickUp,
+				Price:              11000,
+				StartAt:            now.Unix() - 120,
+				History:            []*Point{{Lat: 0, Lng: 0}, {Lat: 2 / 111.195, Lng: 0}},
+				AppliedRate:        &Rate{BasePrice: 1000, PricePerKm: 1000, PricePerMin: 100},
+				SelectedCategory:   &CategoryPrice{Factor: 1},
+				CancellationPolicy: policy,
+			},
+			want: 1000 + 2000 + 200,
+		},
+		{
+			name: "started trip costs at most the price",
+			order: Order{
+				Status:           OrderStatusPickUp,
+				Price:            2500,
+				StartAt:          now.Unix() - 120,
+				History:          []*Point{{Lat: 0, Lng: 0}, {Lat: 2 / 111.195, Lng: 0}},
+				AppliedRate:      &Rate{BasePrice: 1000, PricePerKm: 1000, PricePerMin: 100},
+				SelectedCategory: &CategoryPrice{Factor: 1},
+			},
+			want: 2500,
+		},
+		{
+			name:  "no policy",
+			order: Order{Status: OrderStatusOnTheWay, Price: 5000, AcceptedAt: now.Unix() - 300},
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.CancellationFeeAt(now); got != tt.want {
+				t.Errorf("Order.CancellationFeeAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderChargeCancellation(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	policy := &CancellationPolicy{FreeWindow: 120, Fee: 1000, FeePercent: 10}
+	ord := Order{
+		ID:                 "order",
+		Status:             OrderStatusOnTheWay,
+		Price:              5000,
+		ChargeMethod:       ChargeMethodBalance,
+		AcceptedAt:         now.Unix() - 300,
+		CancellationPolicy: policy,
+	}
+	// the rider cancels once the driver is on the way
+	fee := ord.CancellationFeeAt(now)
+	if err := ord.Transition(OrderStatusCancel, StatusChange{Actor: &User{ID: "rider", Role: RoleRider}}); err != nil {
+		t.Fatal(err)
+	}
+	if !ord.ChargeCancellation(fee) || ord.ChargeStatus != ChargeStatusPending {
+		t.Fatalf("Order.ChargeCancellation(%d) left the charge %q, want pending", fee, ord.ChargeStatus)
+	}
+
+	held := map[string]int{"order": ord.Price}
+	var captured int
+	chargers := Chargers{ChargeMethodBalance: chargerFunc(func(_ context.Context, req ChargeRequest) (*Charge, error) {
+		// capturing the hold releases what is not captured
+		captured = req.Amount
+		delete(held, req.IdempotencyKey)
+		return &Charge{ID: "capture", Status: ChargeStatusSucceeded}, nil
+	})}
+	charge, err := chargers.Charge(context.Background(), ord.ChargeRequest())
+	ord.RecordCharge(charge, err, now)
+	if captured != 1500 || ord.ChargeStatus != ChargeStatusSucceeded || len(held) != 0 {
+		t.Errorf("charged %d, status %q, held %v, want the fee of 1500 captured and the rest released", captured, ord.ChargeStatus, held)
+	}
+
+	cash := Order{Status: OrderStatusCancel, ChargeMethod: ChargeMethodCash}
+	if cash.ChargeCancellation(1500) || cash.CancellationFee != 1500 || cash.ChargeStatus != "" {
+		t.Errorf("Order.ChargeCancellation() of a cash ride = %+v, want the fee only recorded", cash)
+	}
+	free := Order{Status: OrderStatusCancel, ChargeMethod: ChargeMethodBalance}
+	if free.ChargeCancellation(0) {
+		t.Error("Order.ChargeCancellation(0) charged a free cancellation")
+	}
+}
@@ -1,9 +1,24 @@
 package order
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
+	"time"
+)
+
+// ErrChargeDeclined is returned by the chargers when the payment was refused
+// and retrying it would not help.
+var ErrChargeDeclined = errors.New("charge declined")
+
+const (
+	// MaxChargeAttempts is how many times a charge is tried before giving up.
+	MaxChargeAttempts = 5
+	// ChargeRetryDelay is the wait before the first retry of a failed charge.
+	// It doubles after every attempt.
+	ChargeRetryDelay = time.Minute
 )
 
 type ChargeMethod string
@@ -68,3 +83,139 @@ func (e *ChargeMethod) UnmarshalGQL(v interface{}) error {
 func (e ChargeMethod) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type ChargeStatus string
+
+const (
+	// ChargeStatusPending is a charge not made yet or waiting for a retry.
+	ChargeStatusPending ChargeStatus = "PENDING"
+	// ChargeStatusSucceeded is a charge paid by the rider.
+	ChargeStatusSucceeded ChargeStatus = "SUCCEEDED"
+	// ChargeStatusReceivable is a charge the rider paid to the driver in cash.
+	ChargeStatusReceivable ChargeStatus = "RECEIVABLE"
+	// ChargeStatusFailed is a charge declined or out of attempts. It is left
+	// to support.
+	ChargeStatusFailed ChargeStatus = "FAILED"
+)
+
+// ChargeRequest is the payment of a finished ride.
+type ChargeRequest struct {
+	// IdempotencyKey identifies the charge at the provider, so a retried
+	// charge is paid once.
+	IdempotencyKey string
+	Order          string
+	Rider          string
+	Driver         string
+	Method         ChargeMethod
+	Amount         int
+	Currency       string
+}
+
+// Charge is the result of a charge.
+type Charge struct {
+	ID     string
+	Status ChargeStatus
+}
+
+// Charger takes the payment of the rides paid with a charge method.
+type Charger interface {
+	Charge(context.Context, ChargeRequest) (*Charge, error)
+}
+
+// Authorizer is a Charger holding the price of a ride when it is confirmed.
+// The hold is charged when the ride finishes or voided when it is canceled.
+type Authorizer interface {
+	Charger
+	Authorize(context.Context, ChargeRequest) error
+	Void(context.Context, ChargeRequest) error
+}
+
+// Chargers sends every charge to the charger of its method.
+type Chargers map[ChargeMethod]Charger
+
+// Charge implements Charger.
+func (c Chargers) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if req.Amount <= 0 {
+		return &Charge{Status: ChargeStatusSucceeded}, nil
+	}
+	charger, ok := c[req.Method]
+	if !ok {
+		return nil, fmt.Errorf("charge method %q is not configured: %w", req.Method, ErrInvalidInput)
+	}
+	return charger.Charge(ctx, req)
+}
+
+// Authorize implements Authorizer. Only the methods with an Authorizer hold
+// the price, the methods not configured fail with ErrInvalidInput.
+func (c Chargers) Authorize(ctx context.Context, req ChargeRequest) error {
+	charger, ok := c[req.Method]
+	if !ok {
+		return fmt.Errorf("charge method %q is not configured: %w", req.Method, ErrInvalidInput)
+	}
+	if a, ok := charger.(Authorizer); ok && req.Amount > 0 {
+		return a.Authorize(ctx, req)
+	}
+	return nil
+}
+
+// Void implements Authorizer.
+func (c Chargers) Void(ctx context.Context, req ChargeRequest) error {
+	if a, ok := c[req.Method].(Authorizer); ok {
+		return a.Void(ctx, req)
+	}
+	return nil
+}
+
+// PriceHeld reports whether the price of the order is held for its charge
+// method: the order was confirmed and is not finished or canceled yet.
+func (o *Order) PriceHeld() bool {
+	if o.ChargeMethod == "" {
+		return false
+	}
+	switch o.Status {
+	case OrderStatusConfirmed, OrderStatusScheduled, OrderStatusWaitingDriver,
+		OrderStatusOnTheWay, OrderStatusArrived, OrderStatusPickUp:
+		return true
+	}
+	return false
+}
+
+// ChargeRequest returns the payment of the final price of the order, or of
+// the cancellation fee of a canceled order.
+func (o *Order) ChargeRequest() ChargeRequest {
+	amount := o.Price
+	if o.Status == OrderStatusCancel {
+		amount = o.CancellationFee
+	}
+	return ChargeRequest{
+		IdempotencyKey: o.ID,
+		Order:          o.ID,
+		Rider:          o.Rider,
+		Driver:         o.Driver,
+		Method:         o.ChargeMethod,
+		Amount:         amount,
+		Currency:       o.Currency,
+	}
+}
+
+// RecordCharge stores the result of a charge attempt. Failed attempts are
+// retried with a growing delay, declined charges, charges of a method not
+// configured and charges out of attempts fail.
+func (o *Order) RecordCharge(charge *Charge, err error, now time.Time) {
+	o.ChargeAttempts++
+	if err == nil {
+		o.ChargeID = charge.ID
+		o.ChargeStatus = charge.Status
+		o.ChargeError = ""
+		o.ChargeRetryAt = 0
+		return
+	}
+	o.ChargeError = err.Error()
+	if errors.Is(err, ErrChargeDeclined) || errors.Is(err, ErrInvalidInput) || o.ChargeAttempts >= MaxChargeAttempts {
+		o.ChargeStatus = ChargeStatusFailed
+		o.ChargeRetryAt = 0
+		return
+	}
+	o.ChargeStatus = ChargeStatusPending
+	o.ChargeRetryAt = now.Add(ChargeRetryDelay << (o.ChargeAttempts - 1)).Unix()
+}
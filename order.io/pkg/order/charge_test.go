@@ -0,0 +1,95 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type authorizer struct {
+	chargerFunc
+	held map[string]int
+}
+
+func (a *authorizer) Authorize(_ context.Context, req ChargeRequest) error {
+	a.held[req.IdempotencyKey] = req.Amount
+	return nil
+}
+
+func (a *authorizer) Void(_ context.Context, req ChargeRequest) error {
+	delete(a.held, req.IdempotencyKey)
+	return nil
+}
+
+type chargerFunc func(context.Context, ChargeRequest) (*Charge, error)
+
+func (f chargerFunc) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	return f(ctx, req)
+}
+
+func TestChargers(t *testing.T) {
+	chargers := Chargers{
+		ChargeMethodCash: chargerFunc(func(context.Context, ChargeRequest) (*Charge, error) {
+			return &Charge{ID: "cash", Status: ChargeStatusReceivable}, nil
+		}),
+	}
+	ctx := context.Background()
+	if charge, err := chargers.Charge(ctx, ChargeRequest{Method: ChargeMethodCash, Amount: 100}); err != nil || charge.ID != "cash" {
+		t.Errorf("Chargers.Charge() = %v, %v, want the cash charge", charge, err)
+	}
+	if _, err := chargers.Charge(ctx, ChargeRequest{Method: ChargeMethodCard, Amount: 100}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Chargers.Charge() of a method not configured error = %v, want invalid input", err)
+	}
+	if err := chargers.Authorize(ctx, ChargeRequest{Method: ChargeMethodCard, Amount: 100}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Chargers.Authorize() of a method not configured error = %v, want invalid input", err)
+	}
+	if charge, err := chargers.Charge(ctx, ChargeRequest{Method: ChargeMethodCard}); err != nil || charge.Status != ChargeStatusSucceeded {
+		t.Errorf("Chargers.Charge() of nothing = %v, %v, want succeeded", charge, err)
+	}
+
+	balance := &authorizer{held: make(map[string]int)}
+	chargers[ChargeMethodBalance] = balance
+	if err := chargers.Authorize(ctx, ChargeRequest{IdempotencyKey: "1", Method: ChargeMethodBalance, Amount: 100}); err != nil || balance.held["1"] != 100 {
+		t.Errorf("Chargers.Authorize() = %v, held %v, want 100 held", err, balance.held)
+	}
+	if err := chargers.Authorize(ctx, ChargeRequest{IdempotencyKey: "2", Method: ChargeMethodCash, Amount: 100}); err != nil {
+		t.Errorf("Chargers.Authorize() of a method without holds error = %v", err)
+	}
+	if err := chargers.Void(ctx, ChargeRequest{IdempotencyKey: "1", Method: ChargeMethodBalance}); err != nil || len(balance.held) != 0 {
+		t.Errorf("Chargers.Void() = %v, held %v, want nothing held", err, balance.held)
+	}
+}
+
+func TestOrderRecordCharge(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	failure := errors.New("provider unavailable")
+
+	o := &Order{Status: OrderStatusDropOff, ChargeStatus: ChargeStatusPending}
+	o.RecordCharge(nil, failure, now)
+	if o.ChargeStatus != ChargeStatusPending || o.ChargeRetryAt != now.Add(ChargeRetryDelay).Unix() {
+		t.Fatalf("first failure = %v retry at %v, want a retry after %v", o.ChargeStatus, o.ChargeRetryAt, ChargeRetryDelay)
+	}
+	o.RecordCharge(nil, failure, now)
+	if o.ChargeRetryAt != now.Add(2*ChargeRetryDelay).Unix() {
+		t.Errorf("second failure retry at %v, want the delay doubled", o.ChargeRetryAt)
+	}
+	o.RecordCharge(&Charge{ID: "ch_1", Status: ChargeStatusSucceeded}, nil, now)
+	if o.ChargeStatus != ChargeStatusSucceeded || o.ChargeID != "ch_1" || o.ChargeError != "" || o.ChargeRetryAt != 0 {
+		t.Errorf("success = %+v", o)
+	}
+
+	o = &Order{Status: OrderStatusDropOff, ChargeStatus: ChargeStatusPending}
+	o.RecordCharge(nil, ErrChargeDeclined, now)
+	if o.ChargeStatus != ChargeStatusFailed {
+		t.Errorf("declined charge status = %v, want %v", o.ChargeStatus, ChargeStatusFailed)
+	}
+
+	o = &Order{Status: OrderStatusDropOff, ChargeStatus: ChargeStatusPending}
+	for i := 0; i < MaxChargeAttempts; i++ {
+		o.RecordCharge(nil, failure, now)
+	}
+	if o.ChargeStatus != ChargeStatusFailed || o.ChargeAttempts != MaxChargeAttempts {
+		t.Errorf("charge out of attempts = %v after %d attempts, want %v", o.ChargeStatus, o.ChargeAttempts, ChargeStatusFailed)
+	}
+}
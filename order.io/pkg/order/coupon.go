@@ -0,0 +1,181 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	// DiscountTypePercentage takes Value percent off the price.
+	DiscountTypePercentage DiscountType = "PERCENTAGE"
+	// DiscountTypeFixed takes Value off the price, in the coupon currency.
+	DiscountTypeFixed DiscountType = "FIXED"
+)
+
+func (e DiscountType) IsValid() bool {
+	switch e {
+	case DiscountTypePercentage, DiscountTypeFixed:
+		return true
+	}
+	return false
+}
+
+// Coupon is a promotion code riders apply when requesting a ride.
+type Coupon struct {
+	ID    string       `json:"id" bson:"_id"`
+	Code  string       `json:"code" bson:"code"`
+	Type  DiscountType `json:"type" bson:"type"`
+	Value int          `json:"value" bson:"value"`
+	// MaxDiscount caps the discount of percentage coupons. Zero is no cap.
+	MaxDiscount int    `json:"max_discount,omitempty" bson:"max_discount,omitempty"`
+	Currency    string `json:"currency,omitempty" bson:"currency,omitempty"`
+	// StartAt and EndAt bound the validity window in unix seconds. Zero is
+	// unbounded.
+	StartAt int64 `json:"start_at,omitempty" bson:"start_at,omitempty"`
+	EndAt   int64 `json:"end_at,omitempty" bson:"end_at,omitempty"`
+	// MaxUses and MaxUsesPerUser limit the redemptions. Zero is unlimited.
+	MaxUses        int  `json:"max_uses,omitempty" bson:"max_uses,omitempty"`
+	MaxUsesPerUser int  `json:"max_uses_per_user,omitempty" bson:"max_uses_per_user,omitempty"`
+	FirstRideOnly  bool `json:"first_ride_only,omitempty" bson:"first_ride_only,omitempty"`
+	// Categories the coupon applies to. Empty applies to all of them.
+	Categories []VehicleCategory `json:"categories,omitempty" bson:"categories,omitempty"`
+	Disabled   bool              `json:"disabled,omitempty" bson:"disabled,omitempty"`
+
+	// Uses and UserUses count the redemptions of orders not canceled.
+	Uses     int            `json:"uses" bson:"uses"`
+	UserUses map[string]int `json:"-" bson:"user_uses,omitempty"`
+}
+
+// NormalizeCouponCode returns the code as stored, coupon codes are case
+// insensitive.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (c *Coupon) Validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("code is required: %w", ErrInvalidInput)
+	}
+	if !c.Type.IsValid() {
+		return fmt.Errorf("invalid discount type %q: %w", c.Type, ErrInvalidInput)
+	}
+	if c.Value <= 0 {
+		return fmt.Errorf("value is required: %w", ErrInvalidInput)
+	}
+	if c.Type == DiscountTypePercentage && c.Value > 100 {
+		return fmt.Errorf("percentage must be up to 100: %w", ErrInvalidInput)
+	}
+	if c.MaxDiscount < 0 || c.MaxUses < 0 || c.MaxUsesPerUser < 0 {
+		return fmt.Errorf("limits must not be negative: %w", ErrInvalidInput)
+	}
+	if c.StartAt != 0 && c.EndAt != 0 && c.EndAt <= c.StartAt {
+		return fmt.Errorf("end must be after start: %w", ErrInvalidInput)
+	}
+	for _, category := range c.Categories {
+		if !category.IsValid() {
+			return fmt.Errorf("invalid category %q: %w", category, ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// Check reports why the coupon cannot be redeemed by the user at the given
+// time, if so. rides is the number of other rides the user confirmed and did
+// not cancel.
+func (c *Coupon) Check(user string, rides int, at time.Time) error {
+	switch {
+	case c.Disabled:
+		return NewInvalidParameter("coupon", "coupon is disabled")
+	case c.StartAt != 0 && at.Unix() < c.StartAt:
+		return NewInvalidParameter("coupon", "coupon is not valid yet")
+	case c.EndAt != 0 && at.Unix() >= c.EndAt:
+		return NewInvalidParameter("coupon", "coupon expired")
+	case c.MaxUses != 0 && c.Uses >= c.MaxUses:
+		return NewInvalidParameter("coupon", "coupon was fully redeemed")
+	case c.MaxUsesPerUser != 0 && c.UserUses[user] >= c.MaxUsesPerUser:
+		return NewInvalidParameter("coupon", "coupon was already redeemed")
+	case c.FirstRideOnly && rides > 0:
+		return NewInvalidParameter("coupon", "coupon is only valid for the first ride")
+	}
+	return nil
+}
+
+// AppliesTo reports whether the coupon discounts rides of the category in
+// the currency.
+func (c *Coupon) AppliesTo(category VehicleCategory, currency string) bool {
+	if c.Type == DiscountTypeFixed && c.Currency != "" && currency != "" && c.Currency != currency {
+		return false
+	}
+	return len(c.Categories) == 0 || slices.Contains(c.Categories, category)
+}
+
+// Discount returns the amount taken off the price, never more than the
+// price.
+func (c *Coupon) Discount(price int) int {
+	var discount int
+	switch c.Type {
+	case DiscountTypePercentage:
+		discount = int(math.Round(float64(price) * float64(c.Value) / 100))
+		if c.MaxDiscount > 0 {
+			discount = min(discount, c.MaxDiscount)
+		}
+	case DiscountTypeFixed:
+		discount = c.Value
+	}
+	return max(0, min(discount, price))
+}
+
+// ApplyCoupon discounts the category prices the coupon applies to.
+func (o *Order) ApplyCoupon(c *Coupon) {
+	o.Coupon = ""
+	if c != nil {
+		o.Coupon = c.Code
+	}
+	for _, p := range o.CategoryPrice {
+		if c == nil || !c.AppliesTo(p.Category, p.Currency) {
+			continue
+		}
+		p.Discount = c.Discount(p.Price)
+		p.Price -= p.Discount
+		if p.Breakdown != nil {
+			p.Breakdown.ApplyDiscount(p.Discount)
+		}
+	}
+}
+
+type CouponRequest struct {
+	ID             string            `json:"id"`
+	Code           string            `json:"code"`
+	Type           DiscountType      `json:"type"`
+	Value          int               `json:"value"`
+	MaxDiscount    int               `json:"max_discount,omitempty"`
+	Currency       string            `json:"currency,omitempty"`
+	StartAt        int64             `json:"start_at,omitempty"`
+	EndAt          int64             `json:"end_at,omitempty"`
+	MaxUses        int               `json:"max_uses,omitempty"`
+	MaxUsesPerUser int               `json:"max_uses_per_user,omitempty"`
+	FirstRideOnly  bool              `json:"first_ride_only,omitempty"`
+	Categories     []VehicleCategory `json:"categories,omitempty"`
+	Disabled       bool              `json:"disabled,omitempty"`
+}
+
+type CouponFilter struct {
+	Ids   []string
+	Token string
+	Limit int
+	Code  []string
+}
+
+type CouponService interface {
+	Create(context.Context, CouponRequest) (*Coupon, error)
+	Update(context.Context, CouponRequest) (*Coupon, error)
+	FindByID(context.Context, string) (*Coupon, error)
+	FindByCode(context.Context, string) (*Coupon, error)
+	FindAll(context.Context, CouponFilter) ([]*Coupon, string, error)
+}
@@ -0,0 +1,71 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCouponDiscount(t *testing.T) {
+	tests := []struct {
+		name   string
+		coupon Coupon
+		price  int
+		want   int
+	}{
+		{"percentage", Coupon{Type: DiscountTypePercentage, Value: 20}, 10000, 2000},
+		{"percentage capped", Coupon{Type: DiscountTypePercentage, Value: 50, MaxDiscount: 3000}, 10000, 3000},
+		{"fixed", Coupon{Type: DiscountTypeFixed, Value: 1500}, 10000, 1500},
+		{"fixed above the price", Coupon{Type: DiscountTypeFixed, Value: 15000}, 10000, 10000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.coupon.Discount(tt.price); got != tt.want {
+				t.Errorf("Coupon.Discount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponCheck(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		coupon  Coupon
+		rides   int
+		wantErr bool
+	}{
+		{"valid", Coupon{StartAt: now.Unix() - 10, EndAt: now.Unix() + 10}, 3, false},
+		{"disabled", Coupon{Disabled: true}, 0, true},
+		{"not started", Coupon{StartAt: now.Unix() + 10}, 0, true},
+		{"expired", Coupon{EndAt: now.Unix()}, 0, true},
+		{"fully redeemed", Coupon{MaxUses: 10, Uses: 10}, 0, true},
+		{"redeemed by the user", Coupon{MaxUsesPerUser: 1, UserUses: map[string]int{"rider": 1}}, 0, true},
+		{"redeemed by other users", Coupon{MaxUsesPerUser: 1, UserUses: map[string]int{"other": 1}}, 0, false},
+		{"first ride", Coupon{FirstRideOnly: true}, 0, false},
+		{"not the first ride", Coupon{FirstRideOnly: true}, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.coupon.Check("rider", tt.rides, now); (err != nil) != tt.wantErr {
+				t.Errorf("Coupon.Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderApplyCoupon(t *testing.T) {
+	o := &Order{CategoryPrice: []*CategoryPrice{
+		{Category: VehicleCategoryX, Price: 10000, Currency: "CUP"},
+		{Category: VehicleCategoryXl, Price: 15000, Currency: "CUP"},
+	}}
+	o.ApplyCoupon(&Coupon{Code: "WELCOME", Type: DiscountTypeFixed, Value: 2000, Currency: "CUP", Categories: []VehicleCategory{VehicleCategoryX}})
+	if o.Coupon != "WELCOME" {
+		t.Errorf("Coupon = %q", o.Coupon)
+	}
+	if p := o.CategoryPrice[0]; p.Price != 8000 || p.Discount != 2000 {
+		t.Errorf("X price = %+v, want discounted", p)
+	}
+	if p := o.CategoryPrice[1]; p.Price != 15000 || p.Discount != 0 {
+		t.Errorf("XL price = %+v, want not discounted", p)
+	}
+}
@@ -0,0 +1,227 @@
+package order
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxPackageWeight is the heaviest package in kg a driver carries.
+const maxPackageWeight = 30
+
+const (
+	// MaxOTPAttempts is the number of wrong recipient codes locking the code
+	// check of a delivery.
+	MaxOTPAttempts = 5
+	// OTPLockout is how long the code check is locked after too many wrong
+	// codes. Signatures and photos are still accepted meanwhile.
+	OTPLockout = 15 * time.Minute
+)
+
+type PackageSize string
+
+const (
+	PackageSizeSmall  PackageSize = "SMALL"
+	PackageSizeMedium PackageSize = "MEDIUM"
+	PackageSizeLarge  PackageSize = "LARGE"
+)
+
+var AllPackageSize = []PackageSize{
+	PackageSizeSmall,
+	PackageSizeMedium,
+	PackageSizeLarge,
+}
+
+func (e PackageSize) IsValid() bool {
+	switch e {
+	case PackageSizeSmall, PackageSizeMedium, PackageSizeLarge:
+		return true
+	}
+	return false
+}
+
+func (e PackageSize) String() string {
+	return string(e)
+}
+
+func (e *PackageSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PackageSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PackageSize", str)
+	}
+	return nil
+}
+
+func (e PackageSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Delivery holds the package of a delivery order and its recipient.
+type Delivery struct {
+	RecipientName  string      `json:"recipient_name" bson:"recipient_name"`
+	RecipientPhone string      `json:"recipient_phone" bson:"recipient_phone"`
+	Size           PackageSize `json:"size" bson:"size"`
+	// Weight in kg.
+	Weight       float64 `json:"weight" bson:"weight"`
+	Instructions string  `json:"instructions,omitempty" bson:"instructions,omitempty"`
+
+	// OTP is the code the recipient gives to the driver at drop off. It is
+	// only shown to the recipient on the tracking page.
+	OTP string `json:"-" bson:"otp"`
+	// TrackingToken gives access to the tracking page without an account.
+	TrackingToken string         `json:"-" bson:"tracking_token"`
+	Proof         *DeliveryProof `json:"proof,omitempty" bson:"proof,omitempty"`
+	// OTPAttempts counts the wrong codes since the last lockout and
+	// OTPLockedUntil is when the codes are checked again.
+	OTPAttempts    int   `json:"-" bson:"otp_attempts,omitempty"`
+	OTPLockedUntil int64 `json:"-" bson:"otp_locked_until,omitempty"`
+}
+
+// DeliveryProof is captured by the driver at drop off.
+type DeliveryProof struct {
+	OTPVerified bool `json:"otp_verified,omitempty" bson:"otp_verified,omitempty"`
+	// Signature and Photo are URLs of the uploaded images.
+	Signature   string `json:"signature,omitempty" bson:"signature,omitempty"`
+	Photo       string `json:"photo,omitempty" bson:"photo,omitempty"`
+	DeliveredAt int64  `json:"delivered_at" bson:"delivered_at"`
+}
+
+func (d *Delivery) Validate() error {
+	if d.RecipientName == "" {
+		return NewMissingParameter("recipient_name")
+	}
+	if d.RecipientPhone == "" {
+		return NewMissingParameter("recipient_phone")
+	}
+	if !d.Size.IsValid() {
+		return NewInvalidParameter("size", d.Size)
+	}
+	if d.Weight <= 0 || d.Weight > maxPackageWeight {
+		return NewInvalidParameter("weight", d.Weight)
+	}
+	return nil
+}
+
+// Prepare generates the recipient OTP and the tracking token.
+func (d *Delivery) Prepare() error {
+	d.OTP = NewOtp()
+	token := make([]byte, 24)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("unable to generate tracking token: %v: %w", err, ErrInternal)
+	}
+	d.TrackingToken = base64.RawURLEncoding.EncodeToString(token)
+	return nil
+}
+
+// Prove records the proof of delivery. The recipient code, when given, must
+// match; otherwise a signature or a photo is required. Wrong codes fail with
+// ErrInvalidOTP and are counted, the code check is locked with ErrLocked
+// after MaxOTPAttempts of them, so the caller must store the delivery on
+// both errors.
+func (d *Delivery) Prove(proof DeliveryProof, otp string, now time.Time) error {
+	proof.OTPVerified = false
+	if otp != "" {
+		if now.Unix() < d.OTPLockedUntil {
+			return errOTPLocked()
+		}
+		if subtle.ConstantTimeCompare([]byte(otp), []byte(d.OTP)) != 1 {
+			d.OTPAttempts++
+			if d.OTPAttempts < MaxOTPAttempts {
+				left := MaxOTPAttempts - d.OTPAttempts
+				return NewError(ErrInvalidOTP, http.StatusBadRequest, fmt.Sprintf("the recipient code does not match, %d attempts left", left))
+			}
+			d.OTPAttempts = 0
+			d.OTPLockedUntil = now.Add(OTPLockout).Unix()
+			return errOTPLocked()
+		}
+		d.OTPAttempts = 0
+		proof.OTPVerified = true
+	} else if proof.Signature == "" && proof.Photo == "" {
+		return fmt.Errorf("a recipient code, signature or photo is required: %w", ErrInvalidInput)
+	}
+	proof.DeliveredAt = now.Unix()
+	d.Proof = &proof
+	return nil
+}
+
+func errOTPLocked() error {
+	return NewError(ErrLocked, http.StatusLocked, fmt.Sprintf("too many wrong recipient codes, try again in %v", OTPLockout))
+}
+
+// Delivered reports whether a proof of delivery was captured.
+func (d *Delivery) Delivered() bool {
+	return d.Proof != nil
+}
+
+// ProveDelivery records the proof of delivery of a delivery order on the
+// trip.
+func (o *Order) ProveDelivery(proof DeliveryProof, otp string, now time.Time) error {
+	if o.Delivery == nil {
+		return fmt.Errorf("order %s is not a delivery: %w", o.ID, ErrInvalidInput)
+	}
+	if o.Status != OrderStatusPickUp {
+		return fmt.Errorf("order %s is %s: %w", o.ID, o.Status, ErrInvalidTransition)
+	}
+	if o.Delivery.Delivered() {
+		return fmt.Errorf("order %s was already delivered: %w", o.ID, ErrConflict)
+	}
+	return o.Delivery.Prove(proof, otp, now)
+}
+
+// Redact hides the tracking token from the users other than the rider and
+// the admins, since it gives access to the recipient code.
+func (o *Order) Redact(usr *User) {
+	if o.Delivery == nil {
+		return
+	}
+	if usr != nil && (usr.Role == RoleAdmin || usr.ID == o.Rider) {
+		return
+	}
+	d := *o.Delivery
+	d.TrackingToken = ""
+	o.Delivery = &d
+}
+
+// DeliveryTracking is what the recipient sees on the tracking page.
+type DeliveryTracking struct {
+	Order         string      `json:"order"`
+	Status        OrderStatus `json:"status"`
+	RecipientName string      `json:"recipient_name"`
+	// OTP is shown until the package is delivered.
+	OTP            string          `json:"otp,omitempty"`
+	Dropoff        *Point          `json:"dropoff"`
+	DriverPosition *DriverPosition `json:"driver_position,omitempty"`
+	DeliveredAt    int64           `json:"delivered_at,omitempty"`
+}
+
+// Tracking returns the tracking page of a delivery order with the driver at
+// the given location, if any.
+func (o *Order) Tracking(location *Point, now time.Time) *DeliveryTracking {
+	t := &DeliveryTracking{
+		Order:         o.ID,
+		Status:        o.Status,
+		RecipientName: o.Delivery.RecipientName,
+	}
+	if n := len(o.Item.Points); n > 0 {
+		t.Dropoff = o.Item.Points[n-1]
+	}
+	if o.Delivery.Delivered() {
+		t.DeliveredAt = o.Delivery.Proof.DeliveredAt
+	} else if !o.Status.IsTerminal() {
+		t.OTP = o.Delivery.OTP
+	}
+	if location != nil && o.Status.HasDriver() {
+		t.DriverPosition = o.DriverPosition(location, now)
+	}
+	return t
+}
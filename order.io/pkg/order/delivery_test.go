@@ -0,0 +1,99 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeliveryValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		delivery Delivery
+		wantErr  bool
+	}{
+		{"valid", Delivery{RecipientName: "Ana", RecipientPhone: "+5355555555", Size: PackageSizeSmall, Weight: 2}, false},
+		{"missing recipient", Delivery{RecipientPhone: "+5355555555", Size: PackageSizeSmall, Weight: 2}, true},
+		{"missing phone", Delivery{RecipientName: "Ana", Size: PackageSizeSmall, Weight: 2}, true},
+		{"invalid size", Delivery{RecipientName: "Ana", RecipientPhone: "+5355555555", Size: "HUGE", Weight: 2}, true},
+		{"too heavy", Delivery{RecipientName: "Ana", RecipientPhone: "+5355555555", Size: PackageSizeLarge, Weight: 31}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.delivery.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderProveDelivery(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	o := &Order{
+		ID:       "order",
+		Rider:    "rider",
+		Status:   OrderStatusArrived,
+		Item:     Item{Points: []*Point{{Lat: 23.1, Lng: -82.3}, {Lat: 23.2, Lng: -82.4}}},
+		Delivery: &Delivery{RecipientName: "Ana", RecipientPhone: "+5355555555", Size: PackageSizeSmall, Weight: 2},
+	}
+	if err := o.Delivery.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+	if o.Delivery.OTP == "" || o.Delivery.TrackingToken == "" {
+		t.Fatal("Prepare() did not generate the code and the tracking token")
+	}
+	if err := o.ProveDelivery(DeliveryProof{Photo: "photo"}, "", now); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("ProveDelivery() before pickup error = %v", err)
+	}
+
+	o.Status = OrderStatusPickUp
+	if tr := o.Tracking(nil, now); tr.OTP != o.Delivery.OTP || tr.DeliveredAt != 0 {
+		t.Errorf("Tracking() = %+v, want the code shown", tr)
+	}
+	if err := o.ProveDelivery(DeliveryProof{}, "", now); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("ProveDelivery() without proof error = %v", err)
+	}
+	if err := o.ProveDelivery(DeliveryProof{}, "wrong", now); err == nil {
+		t.Fatal("ProveDelivery() accepted a wrong code")
+	}
+	if err := o.ProveDelivery(DeliveryProof{Signature: "signature"}, o.Delivery.OTP, now); err != nil {
+		t.Fatal(err)
+	}
+	if !o.Delivery.Delivered() || !o.Delivery.Proof.OTPVerified || o.Delivery.Proof.DeliveredAt != now.Unix() {
+		t.Errorf("Proof = %+v", o.Delivery.Proof)
+	}
+	if err := o.ProveDelivery(DeliveryProof{Photo: "photo"}, "", now); !errors.Is(err, ErrConflict) {
+		t.Fatalf("ProveDelivery() twice error = %v", err)
+	}
+	if tr := o.Tracking(nil, now); tr.OTP != "" || tr.DeliveredAt != now.Unix() {
+		t.Errorf("Tracking() = %+v, want the code hidden once delivered", tr)
+	}
+
+	o.Redact(&User{ID: "driver", Role: RoleDriver})
+	if o.Delivery.TrackingToken != "" {
+		t.Error("Redact() kept the tracking token for the driver")
+	}
+}
+
+func TestDeliveryProveLocksWrongCodes(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	d := &Delivery{OTP: "123456"}
+	for i := 1; i < MaxOTPAttempts; i++ {
+		if err := d.Prove(DeliveryProof{}, "000000", now); !errors.Is(err, ErrInvalidOTP) {
+			t.Fatalf("Prove() wrong code %d error = %v", i, err)
+		}
+	}
+	if err := d.Prove(DeliveryProof{}, "000000", now); !errors.Is(err, ErrLocked) {
+		t.Fatalf("Prove() last wrong code error = %v", err)
+	}
+	// the right code is refused until the lockout ends
+	if err := d.Prove(DeliveryProof{}, "123456", now); !errors.Is(err, ErrLocked) {
+		t.Fatalf("Prove() while locked error = %v", err)
+	}
+	if err := d.Prove(DeliveryProof{}, "123456", now.Add(OTPLockout)); err != nil {
+		t.Fatal(err)
+	}
+	if !d.Proof.OTPVerified || d.OTPAttempts != 0 {
+		t.Errorf("Proof = %+v, attempts = %d", d.Proof, d.OTPAttempts)
+	}
+}
@@ -0,0 +1,196 @@
+package order
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DispatchPolicy configures how an order is offered to drivers.
+type DispatchPolicy struct {
+	// OfferTimeout is the time in seconds a driver has to accept an offer.
+	OfferTimeout int64 `json:"offer_timeout"`
+	// BatchSize is how many drivers receive an offer at the same time.
+	BatchSize int `json:"batch_size"`
+	// Radius is the initial search radius in meters around the pickup point.
+	Radius float64 `json:"radius"`
+	// RadiusStep is added to the radius when no eligible driver is found.
+	RadiusStep float64 `json:"radius_step"`
+	// MaxRadius bounds the radius escalation.
+	MaxRadius float64 `json:"max_radius"`
+	// Deadline is the time in seconds after which an order nobody accepted
+	// moves to OrderStatusNoDriver.
+	Deadline int64 `json:"deadline"`
+}
+
+// DefaultDispatchPolicy offers an order to one driver at a time for 20
+// seconds, starting at 2km and up to 10km, and gives up after 5 minutes.
+var DefaultDispatchPolicy = DispatchPolicy{
+	OfferTimeout: 20,
+	BatchSize:    1,
+	Radius:       2000,
+	RadiusStep:   2000,
+	MaxRadius:    10000,
+	Deadline:     300,
+}
+
+func (p *DispatchPolicy) Validate() error {
+	if p.OfferTimeout <= 0 {
+		return fmt.Errorf("offer timeout must be positive: %w", ErrInvalidInput)
+	}
+	if p.BatchSize <= 0 {
+		return fmt.Errorf("batch size must be positive: %w", ErrInvalidInput)
+	}
+	if p.Radius <= 0 || p.RadiusStep < 0 {
+		return fmt.Errorf("invalid dispatch radius: %w", ErrInvalidInput)
+	}
+	if p.Radius > p.MaxRadius {
+		return fmt.Errorf("dispatch radius must not exceed the max radius: %w", ErrInvalidInput)
+	}
+	if p.Deadline <= 0 {
+		return fmt.Errorf("dispatch deadline must be positive: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// Candidate is a nearby driver that may receive an offer.
+type Candidate struct {
+	Driver string `json:"driver"`
+	// Distance to the pickup point in meters.
+	Distance float64         `json:"distance"`
+	Category VehicleCategory `json:"category,omitempty"`
+	// Rating is the average rating of the driver between 0 and 5. Zero means
+	// not rated yet.
+	Rating float64 `json:"rating,omitempty"`
+	// AcceptanceRate is the share of offers the driver accepted, between 0
+	// and 1. Drivers without offers yet are assumed to accept.
+	AcceptanceRate float64 `json:"acceptance_rate"`
+}
+
+// Weights of the candidate score. Lower scores are offered first.
+const (
+	distanceWeight   = 0.4
+	categoryWeight   = 0.3
+	ratingWeight     = 0.2
+	acceptanceWeight = 0.1
+
+	// neutralRating is used for drivers not rated yet.
+	neutralRating = 4
+)
+
+func (c *Candidate) score(o *Order, radius float64) float64 {
+	score := distanceWeight * c.Distance / radius
+	if o.SelectedCategory != nil && c.Category != o.SelectedCategory.Category {
+		score += categoryWeight
+	}
+	rating := c.Rating
+	if rating == 0 {
+		rating = neutralRating
+	}
+	score += ratingWeight * (1 - rating/5)
+	score += acceptanceWeight * (1 - c.AcceptanceRate)
+	return score
+}
+
+// DispatchOffer is an order offered to a driver until it expires.
+type DispatchOffer struct {
+	Driver    string `json:"driver" bson:"driver"`
+	OfferedAt int64  `json:"offered_at" bson:"offered_at"`
+	ExpiresAt int64  `json:"expires_at" bson:"expires_at"`
+}
+
+// Dispatch is the state of the search of a driver for an order.
+type Dispatch struct {
+	StartedAt int64            `json:"started_at" bson:"started_at"`
+	Radius    float64          `json:"radius" bson:"radius"`
+	Offers    []*DispatchOffer `json:"offers,omitempty" bson:"offers,omitempty"`
+}
+
+// Offered reports whether the driver holds an offer of the order that has
+// not expired. Orders not dispatched yet are offered to nobody.
+func (o *Order) Offered(driver string, now time.Time) bool {
+	if o.Dispatch == nil {
+		return false
+	}
+	for _, offer := range o.Dispatch.Offers {
+		if offer.Driver == driver && now.Unix() <= offer.ExpiresAt {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Order) pendingOffers(now time.Time) bool {
+	for _, offer := range o.Dispatch.Offers {
+		if now.Unix() <= offer.ExpiresAt {
+			return true
+		}
+	}
+	return false
+}
+
+// DispatchExpired reports whether the order has been searching a driver
+// for longer than the policy deadline.
+func (o *Order) DispatchExpired(policy DispatchPolicy, now time.Time) bool {
+	return o.Dispatch != nil && now.Unix()-o.Dispatch.StartedAt >= policy.Deadline
+}
+
+// NextOffers ranks the candidates and returns the offers to send next. No
+// offers are made while previous ones are pending; drivers banned from the
+// order or already offered it are skipped. When no candidate is eligible the
+// search radius grows by a step, so the caller should look up candidates
+// again within the new DispatchRadius.
+func (o *Order) NextOffers(policy DispatchPolicy, candidates []*Candidate, now time.Time) []*DispatchOffer {
+	if o.Dispatch == nil {
+		o.Dispatch = &Dispatch{StartedAt: now.Unix(), Radius: policy.Radius}
+	}
+	if o.pendingOffers(now) || o.DispatchExpired(policy, now) {
+		return nil
+	}
+	offered := make(map[string]bool, len(o.Dispatch.Offers))
+	for _, offer := range o.Dispatch.Offers {
+		offered[offer.Driver] = true
+	}
+	var eligible []*Candidate
+	for _, c := range candidates {
+		if offered[c.Driver] || o.BannedDrivers[c.Driver] || c.Distance > o.Dispatch.Radius {
+			continue
+		}
+		eligible = append(eligible, c)
+	}
+	if len(eligible) == 0 {
+		o.Dispatch.Radius = min(o.Dispatch.Radius+policy.RadiusStep, policy.MaxRadius)
+		return nil
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].score(o, o.Dispatch.Radius) < eligible[j].score(o, o.Dispatch.Radius)
+	})
+	if len(eligible) > policy.BatchSize {
+		eligible = eligible[:policy.BatchSize]
+	}
+	offers := make([]*DispatchOffer, len(eligible))
+	for i, c := range eligible {
+		offers[i] = &DispatchOffer{
+			Driver:    c.Driver,
+			OfferedAt: now.Unix(),
+			ExpiresAt: now.Unix() + policy.OfferTimeout,
+		}
+	}
+	o.Dispatch.Offers = append(o.Dispatch.Offers, offers...)
+	return offers
+}
+
+// DispatchRadius returns the radius in meters to look up candidates.
+func (o *Order) DispatchRadius(policy DispatchPolicy) float64 {
+	if o.Dispatch == nil {
+		return policy.Radius
+	}
+	return o.Dispatch.Radius
+}
+
+// OfferMessage is published on ChannelOrderOffers so the offered drivers
+// are notified.
+type OfferMessage struct {
+	Order  *Order           `json:"order"`
+	Offers []*DispatchOffer `json:"offers"`
+}
@@ -0,0 +1,93 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOrderNextOffers(t *testing.T) {
+	policy := DispatchPolicy{OfferTimeout: 20, BatchSize: 1, Radius: 1000, RadiusStep: 1000, MaxRadius: 3000, Deadline: 300}
+	start := time.Unix(1700000000, 0)
+	o := &Order{
+		SelectedCategory: &CategoryPrice{Category: VehicleCategoryXl},
+		BannedDrivers:    map[string]bool{"banned": true},
+	}
+	candidates := []*Candidate{
+		{Driver: "banned", Distance: 100, Category: VehicleCategoryXl, AcceptanceRate: 1},
+		{Driver: "near-standard", Distance: 200, Category: VehicleCategoryX, AcceptanceRate: 1},
+		{Driver: "xl", Distance: 600, Category: VehicleCategoryXl, AcceptanceRate: 1},
+		{Driver: "far", Distance: 1500, Category: VehicleCategoryXl, AcceptanceRate: 1},
+	}
+
+	if o.Offered("xl", start) {
+		t.Error("Offered() before the first dispatch")
+	}
+	offers := o.NextOffers(policy, candidates, start)
+	if len(offers) != 1 || offers[0].Driver != "xl" {
+		t.Fatalf("first offers = %+v, want the xl driver", offers)
+	}
+	if !o.Offered("xl", start) || o.Offered("near-standard", start) {
+		t.Error("Offered() mismatch for pending offer")
+	}
+	if offers := o.NextOffers(policy, candidates, start.Add(10*time.Second)); offers != nil {
+		t.Fatalf("offers while pending = %+v", offers)
+	}
+
+	offers = o.NextOffers(policy, candidates, start.Add(21*time.Second))
+	if len(offers) != 1 || offers[0].Driver != "near-standard" {
+		t.Fatalf("second offers = %+v, want the standard driver", offers)
+	}
+	if o.Offered("xl", start.Add(21*time.Second)) {
+		t.Error("expired offer still valid")
+	}
+
+	offers = o.NextOffers(policy, candidates, start.Add(42*time.Second))
+	if offers != nil || o.DispatchRadius(policy) != 2000 {
+		t.Fatalf("offers = %+v, radius = %v, want the radius escalated", offers, o.DispatchRadius(policy))
+	}
+	offers = o.NextOffers(policy, candidates, start.Add(43*time.Second))
+	if len(offers) != 1 || offers[0].Driver != "far" {
+		t.Fatalf("offers after escalation = %+v, want the far driver", offers)
+	}
+
+	if o.DispatchExpired(policy, start.Add(299*time.Second)) || !o.DispatchExpired(policy, start.Add(300*time.Second)) {
+		t.Error("DispatchExpired() mismatch")
+	}
+	if err := CanTransition(OrderStatusConfirmed, OrderStatusNoDriver, RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if !OrderStatusNoDriver.IsTerminal() {
+		t.Error("no driver found must be terminal")
+	}
+}
+
+func TestOrderNextOffersPrefersRatedDrivers(t *testing.T) {
+	policy := DispatchPolicy{OfferTimeout: 20, BatchSize: 1, Radius: 1000, RadiusStep: 1000, MaxRadius: 3000, Deadline: 300}
+	o := &Order{SelectedCategory: &CategoryPrice{Category: VehicleCategoryX}}
+	candidates := []*Candidate{
+		{Driver: "poor", Distance: 500, Category: VehicleCategoryX, Rating: 3.2, AcceptanceRate: 1},
+		{Driver: "unrated", Distance: 500, Category: VehicleCategoryX, AcceptanceRate: 1},
+		{Driver: "good", Distance: 500, Category: VehicleCategoryX, Rating: 4.9, AcceptanceRate: 1},
+	}
+	offers := o.NextOffers(policy, candidates, time.Unix(1700000000, 0))
+	if len(offers) != 1 || offers[0].Driver != "good" {
+		t.Fatalf("offers = %+v, want the better rated driver at equal distance", offers)
+	}
+}
+
+func TestDispatchPolicyValidate(t *testing.T) {
+	if err := DefaultDispatchPolicy.Validate(); err != nil {
+		t.Fatalf("DefaultDispatchPolicy.Validate() error = %v", err)
+	}
+	policy := DefaultDispatchPolicy
+	policy.Radius = policy.MaxRadius + 1
+	if err := policy.Validate(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Validate() of a radius over the max radius error = %v, want invalid input", err)
+	}
+	policy = DefaultDispatchPolicy
+	policy.RadiusStep = -1
+	if err := policy.Validate(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Validate() of a negative radius step error = %v, want invalid input", err)
+	}
+}
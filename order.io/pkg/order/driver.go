@@ -0,0 +1,18 @@
+package order
+
+import "context"
+
+// OnlineDriver is a driver present in the realtime driver index.
+type OnlineDriver struct {
+	Driver   string         `json:"driver"`
+	Location *Point         `json:"location"`
+	LastSeen int64          `json:"last_seen"`
+	OnTrip   bool           `json:"on_trip"`
+	Vehicle  *DriverVehicle `json:"vehicle,omitempty"`
+}
+
+type DriverService interface {
+	// OnlineDrivers returns the drivers that sent a location recently and
+	// did not go offline. This is only available to admins.
+	OnlineDrivers(context.Context) ([]*OnlineDriver, error)
+}
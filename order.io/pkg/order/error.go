@@ -19,6 +19,8 @@ var (
 	ErrInvalidCurrency   = errors.New("invalid currency")
 	ErrUnauthorized      = errors.New("unauthorized")
 	ErrBadRequest        = errors.New("bad request")
+	ErrInvalidOTP        = errors.New("invalid code")
+	ErrLocked            = errors.New("locked")
 
 	ErrInvalid    = errors.New("invalid argument")           // validation failed
 	ErrPermission = errors.New("permission denied")          // permission error action cannot be perform.
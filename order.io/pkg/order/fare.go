@@ -0,0 +1,203 @@
+package order
+
+import (
+	"math"
+	"time"
+)
+
+const earthRadius = 6371000 // meters
+
+// Distance returns the great-circle distance in meters between two points.
+func (p *Point) Distance(to *Point) float64 {
+	lat1 := p.Lat * math.Pi / 180
+	lat2 := to.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (to.Lng - p.Lng) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// PathDistance returns the length in meters of the path through the points.
+func PathDistance(points []*Point) float64 {
+	var distance float64
+	for i := 1; i < len(points); i++ {
+		distance += points[i-1].Distance(points[i])
+	}
+	return distance
+}
+
+// Price returns the price of a trip of the given distance in meters and
+// duration in seconds.
+func (r *Rate) Price(distance, duration float64, riders int) float64 {
+	price := float64(r.BasePrice) +
+		float64(r.PricePerKm)*(distance/1000) +
+		float64(r.PricePerMin)*duration/60 +
+		float64(r.PricePerPassenger)*float64(riders)
+	if r.PricePerBaggage != 0 {
+		price += float64(r.PricePerBaggage)
+	}
+	if r.PricePerCarryPet != 0 {
+		price += float64(r.PricePerCarryPet)
+	}
+	return price
+}
+
+// FareBreakdown explains the price of a category. The lines add up to the
+// total, the tax is included in it.
+type FareBreakdown struct {
+	Base       int `json:"base" bson:"base"`
+	Distance   int `json:"distance" bson:"distance"`
+	Time       int `json:"time" bson:"time"`
+	Passengers int `json:"passengers,omitempty" bson:"passengers,omitempty"`
+	Baggage    int `json:"baggage,omitempty" bson:"baggage,omitempty"`
+	Pet        int `json:"pet,omitempty" bson:"pet,omitempty"`
+	// Surge is the amount added by the surge multiplier.
+	Surge int `json:"surge,omitempty" bson:"surge,omitempty"`
+	// Surcharge is the sum of the surcharges of the pickup and drop off
+	// zones.
+	Surcharge int `json:"surcharge,omitempty" bson:"surcharge,omitempty"`
+	// Discount is the coupon discount taken off the total.
+	Discount int `json:"discount,omitempty" bson:"discount,omitempty"`
+	// TaxRate is the percentage of tax included in the total and Tax the
+	// amount of it.
+	TaxRate float64 `json:"tax_rate,omitempty" bson:"tax_rate,omitempty"`
+	Tax     int     `json:"tax,omitempty" bson:"tax,omitempty"`
+	// Rounding is the difference between the lines rounded on their own and
+	// the total.
+	Rounding int `json:"rounding,omitempty" bson:"rounding,omitempty"`
+}
+
+// Breakdown returns the lines of the price of a trip in a category with the
+// given factor, surge multiplier and zone surcharges.
+func (r *Rate) Breakdown(distance, duration float64, riders int, factor, surge float64, surcharge int) *FareBreakdown {
+	if factor == 0 {
+		factor = 1
+	}
+	if surge < 1 {
+		surge = 1
+	}
+	line := func(amount float64) int {
+		return int(math.Round(amount * factor))
+	}
+	b := &FareBreakdown{
+		Base:       line(float64(r.BasePrice)),
+		Distance:   line(float64(r.PricePerKm) * (distance / 1000)),
+		Time:       line(float64(r.PricePerMin) * duration / 60),
+		Passengers: line(float64(r.PricePerPassenger) * float64(riders)),
+		Baggage:    line(float64(r.PricePerBaggage)),
+		Pet:        line(float64(r.PricePerCarryPet)),
+		Surcharge:  surcharge,
+		TaxRate:    r.TaxRate,
+	}
+	fare := r.Price(distance, duration, riders) * factor
+	b.Surge = int(math.Round(fare * (surge - 1)))
+	total := int(math.Round(fare*surge)) + surcharge
+	b.Rounding = total - b.lines()
+	b.Tax = b.tax(total)
+	return b
+}
+
+// Total returns the price of the breakdown.
+func (b *FareBreakdown) Total() int {
+	return b.lines() + b.Rounding - b.Discount
+}
+
+// ApplyDiscount takes the discount off the total and updates the tax
+// included in it.
+func (b *FareBreakdown) ApplyDiscount(discount int) {
+	b.Discount = discount
+	b.Tax = b.tax(b.Total())
+}
+
+func (b *FareBreakdown) lines() int {
+	return b.Base + b.Distance + b.Time + b.Passengers + b.Baggage + b.Pet + b.Surge + b.Surcharge
+}
+
+func (b *FareBreakdown) tax(total int) int {
+	if b.TaxRate <= 0 {
+		return 0
+	}
+	return int(math.Round(float64(total) * b.TaxRate / (100 + b.TaxRate)))
+}
+
+// ActualDistance returns the distance driven according to the trip
+// breadcrumb, from the pickup to the drop off, falling back to the quoted
+// route distance.
+func (o *Order) ActualDistance() float64 {
+	path := o.tripPath()
+	if n := len(o.Item.Points); n > 1 && o.Item.Points[n-1] != nil {
+		path = append(path, o.Item.Points[n-1])
+	}
+	if len(o.History) == 0 || len(path) < 2 {
+		return o.Distance
+	}
+	return PathDistance(path)
+}
+
+// tripPath is the path driven since the pickup: the pickup point followed by
+// the breadcrumbs.
+func (o *Order) tripPath() []*Point {
+	path := make([]*Point, 0, len(o.History)+2)
+	if pickup := o.trackPickup(); pickup != nil {
+		path = append(path, pickup)
+	}
+	return append(path, o.History...)
+}
+
+// ActualDuration returns the trip duration in seconds between pickup and
+// drop off, falling back to the quoted route duration.
+func (o *Order) ActualDuration() float64 {
+	if o.StartAt == 0 || o.EndAt <= o.StartAt {
+		return o.Duration
+	}
+	return float64(o.EndAt - o.StartAt)
+}
+
+// FinalFare returns the price of the finished trip. The fare is computed from
+// the actual distance and duration and then kept within the tolerance band of
+// the rate around the quoted price, so neither the rider nor the driver pay
+// for large deviations. The coupon discount is taken off and billed waiting
+// time is added on top.
+func (o *Order) FinalFare() int {
+	quoted := o.QuotedPrice
+	if quoted == 0 {
+		// orders confirmed before quotes were recorded
+		quoted = o.Price - o.WaitCharge + o.Discount
+	}
+	if o.AppliedRate == nil || o.SelectedCategory == nil {
+		return max(0, quoted-o.Discount) + o.WaitCharge
+	}
+	fare := o.meteredFare(o.ActualDistance(), o.ActualDuration())
+
+	band := float64(quoted) * o.AppliedRate.FareTolerance / 100
+	fare = math.Max(fare, float64(quoted)-band)
+	fare = math.Min(fare, float64(quoted)+band)
+	return max(0, int(math.Round(fare))-o.Discount) + o.WaitCharge
+}
+
+// TravelledFare returns the price of the part of the trip travelled until
+// now, never more than the price of the order.
+func (o *Order) TravelledFare(now time.Time) int {
+	if o.AppliedRate == nil || o.SelectedCategory == nil || o.StartAt == 0 {
+		return o.Price
+	}
+	duration := float64(max(0, now.Unix()-o.StartAt))
+	fare := o.meteredFare(PathDistance(o.tripPath()), duration)
+	return min(o.Price, max(0, int(math.Round(fare))-o.Discount)+o.WaitCharge)
+}
+
+// meteredFare returns the fare of the applied rate for the given distance in
+// meters and duration in seconds, with the category factor, the surge and the
+// zone surcharges.
+func (o *Order) meteredFare(distance, duration float64) float64 {
+	factor := o.SelectedCategory.Factor
+	if factor == 0 {
+		factor = 1
+	}
+	fare := o.AppliedRate.Price(distance, duration, o.Item.Riders) * factor
+	if o.SurgeMultiplier > 0 {
+		fare *= o.SurgeMultiplier
+	}
+	return fare + float64(o.Surcharge)
+}
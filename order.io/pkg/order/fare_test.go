@@ -0,0 +1,151 @@
+package order
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPointDistance(t *testing.T) {
+	// Havana to Matanzas is roughly 83 km in a straight line.
+	havana := &Point{Lat: 23.1136, Lng: -82.3666}
+	matanzas := &Point{Lat: 23.0411, Lng: -81.5775}
+	if got := havana.Distance(matanzas); math.Abs(got-81000) > 3000 {
+		t.Errorf("Point.Distance() = %v, want about 81000", got)
+	}
+	if got := havana.Distance(havana); got != 0 {
+		t.Errorf("Point.Distance() to itself = %v, want 0", got)
+	}
+}
+
+func TestOrderFinalFare(t *testing.T) {
+	rate := &Rate{BasePrice: 1000, PricePerKm: 1000, FareTolerance: 10}
+	newOrder := func(history []*Point) *Order {
+		return &Order{
+			Distance:         10000,
+			History:          history,
+			AppliedRate:      rate,
+			SelectedCategory: &CategoryPrice{Factor: 1},
+			QuotedPrice:      11000,
+			WaitCharge:       500,
+		}
+	}
+	straight := func(km float64) []*Point {
+		// one degree of latitude is about 111.2 km
+		return []*Point{{Lat: 0, Lng: 0}, {Lat: km / 111.195, Lng: 0}}
+	}
+	tests := []struct {
+		name  string
+		order *Order
+		want  int
+	}{
+		{
+			name:  "no breadcrumb keeps the quote",
+			order: newOrder(nil),
+			want:  11500,
+		},
+		{
+			name:  "small deviation is billed",
+			order: newOrder(straight(10.5)),
+			want:  12000,
+		},
+		{
+			name:  "large detour is capped",
+			order: newOrder(straight(30)),
+			want:  12100 + 500,
+		},
+		{
+			name:  "short cut is floored",
+			order: newOrder(straight(2)),
+			want:  9900 + 500,
+		},
+		{
+			name: "pickup and drop off legs are driven",
+			order: func() *Order {
+				// breadcrumbs from 1 to 9.5 km, pickup at 0 and drop off at 10.5 km
+				o := newOrder([]*Point{{Lat: 1 / 111.195, Lng: 0}, {Lat: 9.5 / 111.195, Lng: 0}})
+				o.Item.Points = []*Point{{Lat: 0, Lng: 0}, {Lat: 10.5 / 111.195, Lng: 0}}
+				return o
+			}(),
+			want: 12000,
+		},
+		{
+			name: "coupon discount is taken off",
+			order: func() *Order {
+				o := newOrder(straight(10.5))
+				o.Discount = 2000
+				return o
+			}(),
+			want: 12000 - 2000,
+		},
+		{
+			name: "surge applies to the actual fare",
+			order: func() *Order {
+				o := newOrder(straight(10.5))
+				o.QuotedPrice = 16500
+				o.SurgeMultiplier = 1.5
+				return o
+			}(),
+			want: 17250 + 500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.FinalFare(); got != tt.want {
+				t.Errorf("Order.FinalFare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateBreakdown(t *testing.T) {
+	rate := &Rate{BasePrice: 1000, PricePerKm: 333, PricePerMin: 50, PricePerPassenger: 100, PricePerBaggage: 200, TaxRate: 10, FareTolerance: 50}
+	tests := []struct {
+		name      string
+		factor    float64
+		surge     float64
+		surcharge int
+	}{
+		{"plain", 1, 1, 0},
+		{"category factor", 1.2, 1, 0},
+		{"surge and surcharge", 1.2, 1.35, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := rate.Breakdown(10500, 700, 2, tt.factor, tt.surge, tt.surcharge)
+			want := int(math.Round(rate.Price(10500, 700, 2)*tt.factor*tt.surge)) + tt.surcharge
+			if got := b.Total(); got != want {
+				t.Fatalf("FareBreakdown.Total() = %v, want %v", got, want)
+			}
+			// a trip driven as quoted is billed the quoted price
+			o := &Order{
+				Distance:         10500,
+				Duration:         700,
+				Item:             Item{Riders: 2},
+				QuotedPrice:      want,
+				AppliedRate:      rate,
+				SelectedCategory: &CategoryPrice{Factor: tt.factor},
+				SurgeMultiplier:  tt.surge,
+				Surcharge:        tt.surcharge,
+			}
+			if got := o.FinalFare(); got != want {
+				t.Errorf("Order.FinalFare() = %v, want %v", got, want)
+			}
+			if b.Rounding < -2 || b.Rounding > 2 {
+				t.Errorf("FareBreakdown.Rounding = %v, want a few units at most", b.Rounding)
+			}
+			if tax := int(math.Round(float64(want) * 10 / 110)); b.Tax != tax {
+				t.Errorf("FareBreakdown.Tax = %v, want %v", b.Tax, tax)
+			}
+		})
+	}
+
+	b := rate.Breakdown(10500, 700, 2, 1, 1, 0)
+	price := b.Total()
+	b.ApplyDiscount(1100)
+	if got := b.Total(); got != price-1100 {
+		t.Errorf("FareBreakdown.Total() with discount = %v, want %v", got, price-1100)
+	}
+	if tax := int(math.Round(float64(price-1100) * 10 / 110)); b.Tax != tax {
+		t.Errorf("FareBreakdown.Tax with discount = %v, want %v", b.Tax, tax)
+	}
+}
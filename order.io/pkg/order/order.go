@@ -10,6 +10,8 @@ import (
 type Point struct {
 	Lat float64 `json:"lat" bson:"lat"`
 	Lng float64 `json:"lon" bson:"lon"`
+	// Timestamp is set on trip breadcrumbs, in unix seconds.
+	Timestamp int64 `json:"timestamp,omitempty" bson:"timestamp,omitempty"`
 }
 
 func (p *Point) String() string {
@@ -26,12 +28,22 @@ type Item struct {
 	Riders   int      `json:"riders" bson:"riders"`
 	Baggages bool     `json:"baggages" bson:"baggages"`
 	Currency string   `json:"currency,omitempty" bson:"currency,omitempty"`
+	// ScheduledAt is the requested pickup time in unix seconds of a ride
+	// booked in advance.
+	ScheduledAt int64 `json:"scheduled_at,omitempty" bson:"-"`
+	// Delivery is the package of a delivery order.
+	Delivery *Delivery `json:"delivery,omitempty" bson:"-"`
 }
 
 type CategoryPrice struct {
 	Category VehicleCategory `json:"category"`
 	Price    int             `json:"price,omitempty"`
 	Currency string          `json:"currency,omitempty"`
+	Factor   float64         `json:"factor,omitempty"`
+	// Discount of the coupon already taken off the price.
+	Discount int `json:"discount,omitempty"`
+	// Breakdown explains the price.
+	Breakdown *FareBreakdown `json:"breakdown,omitempty"`
 }
 
 type Order struct {
@@ -60,6 +72,64 @@ type Order struct {
 	ChargeMethod     ChargeMethod          `json:"charge_method,omitempty" bson:"charge_method,omitempty"`
 	ChargeID         string                `json:"charge_id,omitempty" bson:"charge_id,omitempty"`
 	BannedDrivers    map[string]bool       `json:"banned_drivers,omitempty" bson:"banned_drivers,omitempty"`
+	Version          int64                 `json:"version" bson:"version"`
+
+	AcceptedAt         int64               `json:"accepted_at,omitempty" bson:"accepted_at,omitempty"`
+	CancellationPolicy *CancellationPolicy `json:"cancellation_policy,omitempty" bson:"cancellation_policy,omitempty"`
+	CancellationFee    int                 `json:"cancellation_fee,omitempty" bson:"cancellation_fee,omitempty"`
+
+	ArrivedAt     int64          `json:"arrived_at,omitempty" bson:"arrived_at,omitempty"`
+	WaitingPolicy *WaitingPolicy `json:"waiting_policy,omitempty" bson:"waiting_policy,omitempty"`
+	WaitMinutes   int            `json:"wait_minutes,omitempty" bson:"wait_minutes,omitempty"`
+	WaitCharge    int            `json:"wait_charge,omitempty" bson:"wait_charge,omitempty"`
+
+	AppliedRate *Rate `json:"applied_rate,omitempty" bson:"applied_rate,omitempty"`
+	QuotedPrice int   `json:"quoted_price,omitempty" bson:"quoted_price,omitempty"`
+	FinalPrice  int   `json:"final_price,omitempty" bson:"final_price,omitempty"`
+
+	Dispatch *Dispatch `json:"dispatch,omitempty" bson:"dispatch,omitempty"`
+
+	ScheduledAt int64 `json:"scheduled_at,omitempty" bson:"scheduled_at,omitempty"`
+	RemindedAt  int64 `json:"reminded_at,omitempty" bson:"reminded_at,omitempty"`
+
+	Stops []*Stop `json:"stops,omitempty" bson:"stops,omitempty"`
+
+	Delivery *Delivery `json:"delivery,omitempty" bson:"delivery,omitempty"`
+
+	// Discount is the coupon discount of the selected category, locked when
+	// the order is confirmed. QuotedPrice is before the discount.
+	Discount       int  `json:"discount,omitempty" bson:"discount,omitempty"`
+	CouponRedeemed bool `json:"coupon_redeemed,omitempty" bson:"coupon_redeemed,omitempty"`
+
+	// SurgeMultiplier is the surge of the pickup cell when the order was
+	// quoted, already applied to the category prices.
+	SurgeMultiplier float64 `json:"surge_multiplier,omitempty" bson:"surge_multiplier,omitempty"`
+
+	// Zone is the pricing zone of the pickup point and Surcharge the pickup
+	// and drop off surcharges of the zones, already in the category prices.
+	Zone      string `json:"zone,omitempty" bson:"zone,omitempty"`
+	Surcharge int    `json:"surcharge,omitempty" bson:"surcharge,omitempty"`
+
+	// ChargeStatus is the state of the payment of the final price,
+	// ChargeAttempts the charges tried and ChargeRetryAt when the next one is
+	// due.
+	ChargeStatus   ChargeStatus `json:"charge_status,omitempty" bson:"charge_status,omitempty"`
+	ChargeAttempts int          `json:"charge_attempts,omitempty" bson:"charge_attempts,omitempty"`
+	ChargeError    string       `json:"charge_error,omitempty" bson:"charge_error,omitempty"`
+	ChargeRetryAt  int64        `json:"charge_retry_at,omitempty" bson:"charge_retry_at,omitempty"`
+
+	// Settlement is the split of the fare with the driver, once the fare is
+	// paid.
+	Settlement *Settlement `json:"settlement,omitempty" bson:"settlement,omitempty"`
+}
+
+// VisibleTo reports whether the user may follow the order: its rider, its
+// driver or an admin.
+func (o *Order) VisibleTo(usr *User) bool {
+	if usr == nil {
+		return false
+	}
+	return usr.Role == RoleAdmin || usr.ID == o.Rider || (o.Driver != "" && usr.ID == o.Driver)
 }
 
 func AssambleOrderItem(items *Item) Item {
@@ -100,24 +170,86 @@ type OrderService interface {
 	ConfirmOrder(context.Context, ConfirmOrder) error
 
 	AcceptOrder(context.Context, string) error
+	DriverArrived(context.Context, string) error
+	Track(context.Context, string, []*Point) error
 	StartOrder(context.Context, string) error
-	CancelOrder(context.Context, string) error
+	CancelOrder(context.Context, string, string) error
 	FinishOrder(context.Context, string) error
 	RateOrder(context.Context, string, float64, string) error
 
+	StatusHistory(context.Context, string, StatusHistoryFilter) (*StatusHistoryList, error)
+
 	Categories(context.Context, string) ([]*CategoryPrice, error)
+
+	// Updates streams the changes of an order until the context is done or
+	// the order reaches a terminal status.
+	Updates(context.Context, string) (<-chan *Order, error)
+	// ActiveOrderUpdates streams the changes of any order of the user in
+	// the context until the context is done.
+	ActiveOrderUpdates(context.Context) (<-chan *Order, error)
+	// DriverPositions streams the position of the driver assigned to an
+	// order to its rider while the driver is on the way or on the trip.
+	DriverPositions(context.Context, string) (<-chan *DriverPosition, error)
+
+	// StopArrived and StopDeparted record the assigned driver arrived to or
+	// left an intermediate stop of the trip.
+	StopArrived(context.Context, string, string) error
+	StopDeparted(context.Context, string, string) error
+	// AddStop inserts a stop at the position and RemoveStop removes a stop
+	// not visited yet. The order is priced again. This is only available to
+	// the rider.
+	AddStop(context.Context, string, Point, int) (*Order, error)
+	RemoveStop(context.Context, string, string) (*Order, error)
+
+	// ProveDelivery records the proof of delivery given by the assigned
+	// driver at drop off, using the recipient code when given.
+	ProveDelivery(context.Context, string, DeliveryProof, string) error
+	// TrackDelivery returns the delivery of a tracking token. It does not
+	// require a user.
+	TrackDelivery(context.Context, string) (*DeliveryTracking, error)
 }
 
+// Redis channels where order changes are published.
+const (
+	// ChannelOrders receives orders waiting for a driver.
+	ChannelOrders = "orders"
+	// ChannelOrderConfirmed receives orders accepted by a driver.
+	ChannelOrderConfirmed = "order:confirmed"
+	// ChannelOrderUpdated receives any other order status change.
+	ChannelOrderUpdated = "order:updated"
+	// ChannelOrderOffers receives the offers made to drivers by the dispatcher.
+	ChannelOrderOffers = "order:offers"
+	// ChannelOrderReminder receives the scheduled orders due soon, so their
+	// riders are reminded.
+	ChannelOrderReminder = "order:reminder"
+)
+
 type AddPlace struct {
 	Name  string `json:"name"`
 	Point *Point `json:"point"`
 }
 
 type OrderStatusHistory struct {
+	ID        string      `json:"id" bson:"id"`
 	Status    OrderStatus `json:"status" bson:"status"`
+	Previous  OrderStatus `json:"previous,omitempty" bson:"previous,omitempty"`
+	Actor     string      `json:"actor,omitempty" bson:"actor,omitempty"`
+	ActorRole Role        `json:"actor_role,omitempty" bson:"actor_role,omitempty"`
+	Reason    string      `json:"reason,omitempty" bson:"reason,omitempty"`
+	Location  *Point      `json:"location,omitempty" bson:"location,omitempty"`
 	ChangedAt string      `json:"changed_at" bson:"changed_at"`
 }
 
+type StatusHistoryFilter struct {
+	Limit int    `json:"limit,omitempty"`
+	Token string `json:"token,omitempty"`
+}
+
+type StatusHistoryList struct {
+	Token string                `json:"token"`
+	Data  []*OrderStatusHistory `json:"data"`
+}
+
 type OrderStatus string
 
 const (
@@ -126,8 +258,11 @@ const (
 	OrderStatusPickUp        OrderStatus = "PICKED_UP"
 	OrderStatusConfirmed     OrderStatus = "CONFIRMED"
 	OrderStatusOnTheWay      OrderStatus = "ON_THE_WAY"
+	OrderStatusArrived       OrderStatus = "DRIVER_ARRIVED"
 	OrderStatusDropOff       OrderStatus = "DROPED_OFF"
 	OrderStatusCancel        OrderStatus = "CANCELED"
+	OrderStatusNoDriver      OrderStatus = "NO_DRIVER_FOUND"
+	OrderStatusScheduled     OrderStatus = "SCHEDULED"
 )
 
 var AllOrderStatus = []OrderStatus{
@@ -136,13 +271,16 @@ var AllOrderStatus = []OrderStatus{
 	OrderStatusPickUp,
 	OrderStatusConfirmed,
 	OrderStatusOnTheWay,
+	OrderStatusArrived,
 	OrderStatusDropOff,
 	OrderStatusCancel,
+	OrderStatusNoDriver,
+	OrderStatusScheduled,
 }
 
 func (e OrderStatus) IsValid() bool {
 	switch e {
-	case OrderStatusNew, OrderStatusPickUp, OrderStatusOnTheWay, OrderStatusDropOff, OrderStatusCancel, OrderStatusWaitingDriver:
+	case OrderStatusNew, OrderStatusPickUp, OrderStatusConfirmed, OrderStatusOnTheWay, OrderStatusArrived, OrderStatusDropOff, OrderStatusCancel, OrderStatusWaitingDriver, OrderStatusNoDriver, OrderStatusScheduled:
 		return true
 	}
 	return false
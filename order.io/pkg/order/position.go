@@ -0,0 +1,53 @@
+package order
+
+import (
+	"math"
+	"time"
+)
+
+// driverSpeed is the average urban speed in m/s used to estimate the driver
+// arrival time from the straight line distance.
+const driverSpeed = 8
+
+// roadFactor approximates the road distance from the straight line distance.
+const roadFactor = 1.3
+
+// DriverPosition is the position of the driver assigned to an order.
+type DriverPosition struct {
+	Order    string `json:"order"`
+	Driver   string `json:"driver"`
+	Location *Point `json:"location"`
+	// Distance to the pickup point in meters.
+	Distance float64 `json:"distance"`
+	// ETA is the estimated time in seconds for the driver to reach the
+	// pickup point. It is zero once the driver arrived.
+	ETA       int64 `json:"eta"`
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// HasDriver reports whether a driver is assigned and heading to or
+// carrying the rider.
+func (e OrderStatus) HasDriver() bool {
+	switch e {
+	case OrderStatusOnTheWay, OrderStatusArrived, OrderStatusPickUp:
+		return true
+	}
+	return false
+}
+
+// DriverPosition returns the position of the assigned driver at the given
+// location with the distance and ETA to the pickup point.
+func (o *Order) DriverPosition(location *Point, now time.Time) *DriverPosition {
+	pos := &DriverPosition{
+		Order:     o.ID,
+		Driver:    o.Driver,
+		Location:  location,
+		UpdatedAt: now.Unix(),
+	}
+	if o.Status != OrderStatusOnTheWay || len(o.Item.Points) == 0 {
+		return pos
+	}
+	pos.Distance = location.Distance(o.Item.Points[0]) * roadFactor
+	pos.ETA = int64(math.Ceil(pos.Distance / driverSpeed))
+	return pos
+}
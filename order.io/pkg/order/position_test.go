@@ -0,0 +1,32 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderDriverPosition(t *testing.T) {
+	pickup := &Point{Lat: 23.1136, Lng: -82.3666}
+	driver := &Point{Lat: 23.1226, Lng: -82.3666} // ~1 km north
+	now := time.Unix(1700000000, 0)
+
+	o := &Order{ID: "o", Driver: "d", Status: OrderStatusOnTheWay, Item: Item{Points: []*Point{pickup}}}
+	pos := o.DriverPosition(driver, now)
+	if pos.Distance < 1200 || pos.Distance > 1400 {
+		t.Errorf("Distance = %v, want ~1300", pos.Distance)
+	}
+	if pos.ETA < 150 || pos.ETA > 175 {
+		t.Errorf("ETA = %v, want ~163", pos.ETA)
+	}
+	if pos.UpdatedAt != now.Unix() || pos.Order != "o" || pos.Driver != "d" {
+		t.Errorf("DriverPosition() = %+v", pos)
+	}
+
+	o.Status = OrderStatusArrived
+	if pos := o.DriverPosition(driver, now); pos.ETA != 0 || pos.Distance != 0 {
+		t.Errorf("arrived driver ETA = %v, distance = %v, want 0", pos.ETA, pos.Distance)
+	}
+	if OrderStatusConfirmed.HasDriver() || !OrderStatusPickUp.HasDriver() {
+		t.Error("HasDriver() mismatch")
+	}
+}
@@ -3,6 +3,20 @@ package order
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+	// rates are resolved in their own timezone
+	_ "time/tzdata"
+)
+
+const (
+	rateDateLayout  = "2006-01-02"
+	rateClockLayout = "15:04"
+
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
 )
 
 type Rate struct {
@@ -21,6 +35,24 @@ type Rate struct {
 	MinKm             int    `json:"min_km,omitempty" bson:"min_km,omitempty"`
 	MaxKm             int    `json:"max_km,omitempty" bson:"max_km,omitempty"`
 	HighDemand        bool   `json:"high_demand,omitempty" bson:"high_demand,omitempty"`
+
+	// Priority decides between the rates applying to a ride, the highest
+	// wins. Rates at the same priority must not overlap.
+	Priority int `json:"priority,omitempty" bson:"priority,omitempty"`
+	// Days are the weekdays the time window starts on. Empty is every day.
+	Days []time.Weekday `json:"days,omitempty" bson:"days,omitempty"`
+	// Timezone is the IANA name of the timezone of the dates and times of
+	// the rate. Empty is UTC.
+	Timezone string `json:"timezone,omitempty" bson:"timezone,omitempty"`
+
+	Cancellation CancellationPolicy `json:"cancellation" bson:"cancellation"`
+	Waiting      WaitingPolicy      `json:"waiting" bson:"waiting"`
+
+	// FareTolerance is the percentage the final fare may deviate from the
+	// quoted price. Zero keeps the quoted price.
+	FareTolerance float64 `json:"fare_tolerance,omitempty" bson:"fare_tolerance,omitempty"`
+	// TaxRate is the percentage of tax included in the prices.
+	TaxRate float64 `json:"tax_rate,omitempty" bson:"tax_rate,omitempty"`
 }
 
 func (r *Rate) Validate() error {
@@ -33,10 +65,279 @@ func (r *Rate) Validate() error {
 	if r.PricePerKm <= 0 {
 		return fmt.Errorf("price per km is required: %w", ErrInvalidInput)
 	}
+	if err := r.Cancellation.Validate(); err != nil {
+		return err
+	}
+	if err := r.Waiting.Validate(); err != nil {
+		return err
+	}
+	if r.FareTolerance < 0 || r.FareTolerance > 100 {
+		return fmt.Errorf("fare tolerance must be between 0 and 100: %w", ErrInvalidInput)
+	}
+	if r.TaxRate < 0 || r.TaxRate > 100 {
+		return fmt.Errorf("tax rate must be between 0 and 100: %w", ErrInvalidInput)
+	}
+	if _, err := r.location(); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", r.Timezone, ErrInvalidInput)
+	}
+	if _, _, err := r.window(); err != nil {
+		return err
+	}
+	if _, _, err := r.dates(); err != nil {
+		return err
+	}
+	for _, d := range r.Days {
+		if d < time.Sunday || d > time.Saturday {
+			return fmt.Errorf("invalid weekday %d: %w", d, ErrInvalidInput)
+		}
+	}
+	if r.MinKm < 0 || r.MaxKm < 0 || (r.MaxKm != 0 && r.MaxKm <= r.MinKm) {
+		return fmt.Errorf("max km must be greater than min km: %w", ErrInvalidInput)
+	}
 
 	return nil
 }
 
+func (r *Rate) location() (*time.Location, error) {
+	if r.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(r.Timezone)
+}
+
+// window returns the minutes of the day the time window of the rate starts
+// and ends at. Windows ending before they start run overnight and end the
+// next day.
+func (r *Rate) window() (start, end int, err error) {
+	start, end = 0, minutesPerDay
+	if r.StartTime != "" {
+		if start, err = clockMinutes(r.StartTime); err != nil {
+			return 0, 0, fmt.Errorf("invalid start time %q: %w", r.StartTime, ErrInvalidInput)
+		}
+	}
+	if r.EndTime != "" {
+		if end, err = clockMinutes(r.EndTime); err != nil {
+			return 0, 0, fmt.Errorf("invalid end time %q: %w", r.EndTime, ErrInvalidInput)
+		}
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("start and end time must differ: %w", ErrInvalidInput)
+	}
+	if end < start {
+		end += minutesPerDay
+	}
+	return start, end, nil
+}
+
+func clockMinutes(clock string) (int, error) {
+	t, err := time.Parse(rateClockLayout, clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// dates returns the first and last day, inclusive, the time window of the
+// rate may start on. Zero times are unbounded.
+func (r *Rate) dates() (start, end time.Time, err error) {
+	if r.StartDate != "" {
+		if start, err = time.Parse(rateDateLayout, r.StartDate); err != nil {
+			return start, end, fmt.Errorf("invalid start date %q: %w", r.StartDate, ErrInvalidInput)
+		}
+	}
+	if r.EndDate != "" {
+		if end, err = time.Parse(rateDateLayout, r.EndDate); err != nil {
+			return start, end, fmt.Errorf("invalid end date %q: %w", r.EndDate, ErrInvalidInput)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return start, end, fmt.Errorf("end date must not be before start date: %w", ErrInvalidInput)
+	}
+	return start, end, nil
+}
+
+// Applies reports whether the rate prices a ride at the given time and of
+// the given distance in meters. The time window is [StartTime, EndTime) and
+// the distance range is [MinKm, MaxKm).
+func (r *Rate) Applies(at time.Time, distance float64) bool {
+	km := distance / 1000
+	if km < float64(r.MinKm) || (r.MaxKm > 0 && km >= float64(r.MaxKm)) {
+		return false
+	}
+	loc, err := r.location()
+	if err != nil {
+		return false
+	}
+	start, end, err := r.window()
+	if err != nil {
+		return false
+	}
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	// the day the window started on
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case minute >= start && minute < end:
+	case minute+minutesPerDay < end:
+		day = day.AddDate(0, 0, -1)
+	default:
+		return false
+	}
+	if len(r.Days) > 0 && !slices.Contains(r.Days, day.Weekday()) {
+		return false
+	}
+	first, last, err := r.dates()
+	if err != nil {
+		return false
+	}
+	if !first.IsZero() && day.Before(first) {
+		return false
+	}
+	if !last.IsZero() && day.After(last) {
+		return false
+	}
+	return true
+}
+
+// SelectRate returns the rate of a ride at the given time and of the given
+// distance in meters: the applying rate with the highest priority, then the
+// lowest code, so the choice does not depend on the order of the rates.
+func SelectRate(rates []*Rate, at time.Time, distance float64) (*Rate, error) {
+	var candidates []*Rate
+	for _, r := range rates {
+		if r.Applies(at, distance) {
+			candidates = append(candidates, r)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no rate applies at %s: %w", at.UTC().Format(time.RFC3339), ErrNotFound)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority > candidates[j].Priority
+		}
+		if candidates[i].Code != candidates[j].Code {
+			return candidates[i].Code < candidates[j].Code
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	return candidates[0], nil
+}
+
+// RateLocked reports whether the order keeps its applied rate and policies.
+// They are locked once the order is confirmed, so a change of the route is
+// priced with the rate the rider accepted.
+func (o *Order) RateLocked() bool {
+	return o.Status != OrderStatusNew && o.AppliedRate != nil
+}
+
+// Overlaps reports whether both rates may apply to the same ride at the
+// same priority from the given time on. Weekly windows in different
+// timezones are compared with the offsets at the start of the common dates,
+// or at the given time when the rates have no start date, and half a year
+// later when the common dates last that long, so both sides of a daylight
+// saving change are checked.
+func (r *Rate) Overlaps(other *Rate, at time.Time) bool {
+	if r.Priority != other.Priority {
+		return false
+	}
+	if !kmOverlap(r, other) {
+		return false
+	}
+	first, last, err := r.dates()
+	if err != nil {
+		return false
+	}
+	otherFirst, otherLast, err := other.dates()
+	if err != nil {
+		return false
+	}
+	if !first.IsZero() && !otherLast.IsZero() && otherLast.Before(first) {
+		return false
+	}
+	if !otherFirst.IsZero() && !last.IsZero() && last.Before(otherFirst) {
+		return false
+	}
+	ref := at
+	if first.After(ref) {
+		ref = first
+	}
+	if otherFirst.After(ref) {
+		ref = otherFirst
+	}
+	refs := []time.Time{ref}
+	end := last
+	if end.IsZero() || (!otherLast.IsZero() && otherLast.Before(end)) {
+		end = otherLast
+	}
+	if !end.IsZero() && !end.AddDate(0, 0, 1).After(ref) {
+		// one of the rates no longer applies
+		return false
+	}
+	if later := ref.AddDate(0, 6, 0); end.IsZero() || !end.Before(later) {
+		refs = append(refs, later)
+	}
+	for _, ref := range refs {
+		if windowsOverlap(r.weeklyWindows(ref), other.weeklyWindows(ref)) {
+			return true
+		}
+	}
+	return false
+}
+
+func windowsOverlap(a, b [][2]int) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x[0] < y[1] && y[0] < x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func kmOverlap(a, b *Rate) bool {
+	aMax, bMax := math.Inf(1), math.Inf(1)
+	if a.MaxKm > 0 {
+		aMax = float64(a.MaxKm)
+	}
+	if b.MaxKm > 0 {
+		bMax = float64(b.MaxKm)
+	}
+	return float64(a.MinKm) < bMax && float64(b.MinKm) < aMax
+}
+
+// weeklyWindows returns the windows of the rate in UTC minutes of the week,
+// starting on Sunday, with the timezone offset at the reference time.
+func (r *Rate) weeklyWindows(ref time.Time) [][2]int {
+	loc, err := r.location()
+	if err != nil {
+		return nil
+	}
+	start, end, err := r.window()
+	if err != nil {
+		return nil
+	}
+	_, offset := ref.In(loc).Zone()
+	shift := offset / 60
+	days := r.Days
+	if len(days) == 0 {
+		days = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	}
+	var windows [][2]int
+	for _, d := range days {
+		from := int(d)*minutesPerDay + start - shift
+		from = ((from % minutesPerWeek) + minutesPerWeek) % minutesPerWeek
+		to := from + end - start
+		if to > minutesPerWeek {
+			windows = append(windows, [2]int{from, minutesPerWeek}, [2]int{0, to - minutesPerWeek})
+			continue
+		}
+		windows = append(windows, [2]int{from, to})
+	}
+	return windows
+}
+
 type RateRequest struct {
 	ID                string `json:"id"`
 	Code              string `json:"code"`
@@ -52,6 +353,18 @@ type RateRequest struct {
 	MinKm             int    `json:"min_km,omitempty"`
 	MaxKm             int    `json:"max_km,omitempty"`
 	HiDemand          bool   `json:"high_demand,omitempty"`
+
+	// the optional fields keep the current value of the rate when nil
+	Priority *int            `json:"priority,omitempty"`
+	Days     *[]time.Weekday `json:"days,omitempty"`
+	Timezone string          `json:"timezone,omitempty"`
+
+	Cancellation *CancellationPolicy `json:"cancellation,omitempty"`
+	Waiting      *WaitingPolicy      `json:"waiting,omitempty"`
+
+	// FareTolerance and TaxRate keep the current values of the rate when nil.
+	FareTolerance *float64 `json:"fare_tolerance,omitempty"`
+	TaxRate       *float64 `json:"tax_rate,omitempty"`
 }
 
 type RateFilter struct {
@@ -79,6 +392,9 @@ type VehicleCategoryRate struct {
 	ID       string          `json:"id" bson:"_id"`
 	Category VehicleCategory `json:"category" bson:"category"`
 	Factor   float64         `json:"factor" bson:"factor"`
+	// Commission is the percentage of the fares of the category the
+	// platform keeps. Zero is the default commission.
+	Commission float64 `json:"commission,omitempty" bson:"commission,omitempty"`
 }
 
 type VehicleCategoryRateFilter struct {
@@ -89,9 +405,10 @@ type VehicleCategoryRateFilter struct {
 }
 
 type VehicleCategoryRateRequest struct {
-	ID       string          `json:"id"`
-	Category VehicleCategory `json:"category"`
-	Factor   float64         `json:"factor"`
+	ID         string          `json:"id"`
+	Category   VehicleCategory `json:"category"`
+	Factor     float64         `json:"factor"`
+	Commission float64         `json:"commission,omitempty"`
 }
 
 func (r *VehicleCategoryRate) Validate() error {
@@ -101,6 +418,9 @@ func (r *VehicleCategoryRate) Validate() error {
 	if r.Factor <= 0 {
 		return fmt.Errorf("factor is required: %w", ErrInvalidInput)
 	}
+	if r.Commission < 0 || r.Commission > 100 {
+		return fmt.Errorf("commission must be between 0 and 100: %w", ErrInvalidInput)
+	}
 
 	return nil
 }
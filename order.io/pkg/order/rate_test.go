@@ -0,0 +1,122 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateApplies(t *testing.T) {
+	// Friday 2024-03-01
+	at := func(clock string) time.Time {
+		t, _ := time.Parse("2006-01-02 15:04", "2024-03-01 "+clock)
+		return t
+	}
+	tests := []struct {
+		name     string
+		rate     Rate
+		at       time.Time
+		distance float64
+		want     bool
+	}{
+		{"always", Rate{}, at("12:00"), 5000, true},
+		{"inside the window", Rate{StartTime: "08:30", EndTime: "16:00"}, at("08:30"), 5000, true},
+		{"window end is excluded", Rate{StartTime: "08:30", EndTime: "16:00"}, at("16:00"), 5000, false},
+		{"overnight before midnight", Rate{StartTime: "22:00", EndTime: "05:30"}, at("23:10"), 5000, true},
+		{"overnight after midnight", Rate{StartTime: "22:00", EndTime: "05:30"}, at("03:00"), 5000, true},
+		{"overnight outside", Rate{StartTime: "22:00", EndTime: "05:30"}, at("12:00"), 5000, false},
+		{"weekday", Rate{Days: []time.Weekday{time.Friday}}, at("12:00"), 5000, true},
+		{"other weekday", Rate{Days: []time.Weekday{time.Saturday}}, at("12:00"), 5000, false},
+		{"overnight started the day before", Rate{StartTime: "22:00", EndTime: "05:30", Days: []time.Weekday{time.Thursday}}, at("03:00"), 5000, true},
+		{"inside the dates", Rate{StartDate: "2024-02-28", EndDate: "2024-03-01"}, at("23:59"), 5000, true},
+		{"before the dates", Rate{StartDate: "2024-03-02"}, at("12:00"), 5000, false},
+		{"after the dates", Rate{EndDate: "2024-02-29"}, at("12:00"), 5000, false},
+		{"timezone", Rate{StartTime: "08:00", EndTime: "09:00", Timezone: "America/Havana"}, at("13:30"), 5000, true},
+		{"timezone outside", Rate{StartTime: "08:00", EndTime: "09:00", Timezone: "America/Havana"}, at("08:30"), 5000, false},
+		{"below min km", Rate{MinKm: 10}, at("12:00"), 5000, false},
+		{"max km is excluded", Rate{MaxKm: 5}, at("12:00"), 5000, false},
+		{"inside the km range", Rate{MinKm: 5, MaxKm: 10}, at("12:00"), 5000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rate.Applies(tt.at, tt.distance); got != tt.want {
+				t.Errorf("Rate.Applies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectRate(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	base := &Rate{ID: "1", Code: "BASE"}
+	day := &Rate{ID: "2", Code: "DAY", StartTime: "08:00", EndTime: "20:00", Priority: 1}
+	holiday := &Rate{ID: "3", Code: "HOLIDAY", StartDate: "2024-03-01", EndDate: "2024-03-01", Priority: 2}
+
+	rate, err := SelectRate([]*Rate{holiday, base, day}, at, 5000)
+	if err != nil || rate != holiday {
+		t.Fatalf("SelectRate() = %v, %v, want the holiday rate", rate, err)
+	}
+	rate, err = SelectRate([]*Rate{day, base}, at.Add(10*time.Hour), 5000)
+	if err != nil || rate != base {
+		t.Fatalf("SelectRate() at night = %v, %v, want the base rate", rate, err)
+	}
+	if _, err := SelectRate([]*Rate{day}, at.Add(10*time.Hour), 5000); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("SelectRate() without a rate error = %v", err)
+	}
+}
+
+func TestOrderRateLocked(t *testing.T) {
+	rate := &Rate{ID: "1", Code: "BASE"}
+	tests := []struct {
+		name  string
+		order Order
+		want  bool
+	}{
+		{"quoted", Order{Status: OrderStatusNew, AppliedRate: rate}, false},
+		{"not priced", Order{Status: OrderStatusConfirmed}, false},
+		{"scheduled", Order{Status: OrderStatusScheduled, AppliedRate: rate}, true},
+		{"in progress", Order{Status: OrderStatusPickUp, AppliedRate: rate}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.RateLocked(); got != tt.want {
+				t.Errorf("Order.RateLocked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Rate
+		want bool
+	}{
+		{"adjacent windows", Rate{StartTime: "05:30", EndTime: "08:30"}, Rate{StartTime: "08:30", EndTime: "16:00"}, false},
+		{"overlapping windows", Rate{StartTime: "05:30", EndTime: "09:00"}, Rate{StartTime: "08:30", EndTime: "16:00"}, true},
+		{"different priority", Rate{}, Rate{Priority: 1}, false},
+		{"overnight into the morning", Rate{StartTime: "22:00", EndTime: "06:00"}, Rate{StartTime: "05:30", EndTime: "08:30"}, true},
+		{"overnight and the evening", Rate{StartTime: "23:30", EndTime: "05:30"}, Rate{StartTime: "20:00", EndTime: "23:30"}, false},
+		{"different days", Rate{Days: []time.Weekday{time.Monday}}, Rate{Days: []time.Weekday{time.Tuesday}}, false},
+		{"overnight into the next day", Rate{StartTime: "22:00", EndTime: "02:00", Days: []time.Weekday{time.Monday}}, Rate{StartTime: "01:00", EndTime: "03:00", Days: []time.Weekday{time.Tuesday}}, true},
+		{"saturday night into sunday", Rate{StartTime: "22:00", EndTime: "02:00", Days: []time.Weekday{time.Saturday}}, Rate{StartTime: "01:00", EndTime: "03:00", Days: []time.Weekday{time.Sunday}}, true},
+		{"disjoint dates", Rate{EndDate: "2024-01-31"}, Rate{StartDate: "2024-02-01"}, false},
+		{"disjoint km ranges", Rate{MaxKm: 10}, Rate{MinKm: 10}, false},
+		{"timezones", Rate{StartTime: "08:00", EndTime: "09:00", Timezone: "America/Havana", StartDate: "2024-03-01"}, Rate{StartTime: "13:30", EndTime: "14:00", StartDate: "2024-03-01"}, true},
+		{"undated across daylight saving", Rate{StartTime: "08:00", EndTime: "09:00", Timezone: "America/Havana"}, Rate{StartTime: "12:30", EndTime: "13:00"}, true},
+		{"expired", Rate{EndDate: "2023-12-31"}, Rate{}, false},
+		{"dated before daylight saving", Rate{StartTime: "08:00", EndTime: "09:00", Timezone: "America/Havana", EndDate: "2024-02-29"}, Rate{StartTime: "12:30", EndTime: "13:00"}, false},
+	}
+	// in standard time, Havana is 5 hours behind UTC and 4 in daylight time
+	at := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(&tt.b, at); got != tt.want {
+				t.Errorf("Rate.Overlaps() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Overlaps(&tt.a, at); got != tt.want {
+				t.Errorf("Rate.Overlaps() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package order
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MinScheduleLead is how long in advance a ride must be booked.
+	MinScheduleLead = 30 * time.Minute
+	// MaxScheduleAhead bounds how far in the future a ride can be booked.
+	MaxScheduleAhead = 30 * 24 * time.Hour
+)
+
+// ValidateSchedule checks a requested pickup time in unix seconds. Zero
+// means an immediate ride.
+func ValidateSchedule(at int64, now time.Time) error {
+	if at == 0 {
+		return nil
+	}
+	pickup := time.Unix(at, 0)
+	if pickup.Before(now.Add(MinScheduleLead)) {
+		return fmt.Errorf("rides must be scheduled at least %s in advance: %w", MinScheduleLead, ErrInvalidInput)
+	}
+	if pickup.After(now.Add(MaxScheduleAhead)) {
+		return fmt.Errorf("rides cannot be scheduled more than %s in advance: %w", MaxScheduleAhead, ErrInvalidInput)
+	}
+	return nil
+}
+
+// PickupTime returns the scheduled pickup time of the order, or now for
+// immediate rides.
+func (o *Order) PickupTime(now time.Time) time.Time {
+	if o.ScheduledAt == 0 {
+		return now
+	}
+	return time.Unix(o.ScheduledAt, 0)
+}
+
+// DueForDispatch reports whether a scheduled order must be offered to the
+// drivers, lead ahead of the pickup time.
+func (o *Order) DueForDispatch(now time.Time, lead time.Duration) bool {
+	return o.Status == OrderStatusScheduled && !now.Add(lead).Before(o.PickupTime(now))
+}
+
+// DueForReminder reports whether the rider of a scheduled order must be
+// reminded of the ride, lead ahead of the pickup time.
+func (o *Order) DueForReminder(now time.Time, lead time.Duration) bool {
+	return o.Status == OrderStatusScheduled && o.RemindedAt == 0 && !now.Add(lead).Before(o.PickupTime(now))
+}
@@ -0,0 +1,49 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		at      int64
+		wantErr error
+	}{
+		{name: "immediate ride"},
+		{name: "enough lead time", at: now.Add(time.Hour).Unix()},
+		{name: "too soon", at: now.Add(10 * time.Minute).Unix(), wantErr: ErrInvalidInput},
+		{name: "too far", at: now.Add(60 * 24 * time.Hour).Unix(), wantErr: ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSchedule(tt.at, now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateSchedule() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderDueForDispatch(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	o := &Order{Status: OrderStatusScheduled, ScheduledAt: now.Add(40 * time.Minute).Unix()}
+	if o.DueForDispatch(now, 15*time.Minute) {
+		t.Error("dispatched 40 minutes ahead")
+	}
+	if !o.DueForReminder(now, time.Hour) {
+		t.Error("reminder not due an hour ahead")
+	}
+	o.RemindedAt = now.Unix()
+	if o.DueForReminder(now, time.Hour) {
+		t.Error("reminder due twice")
+	}
+	if !o.DueForDispatch(now.Add(25*time.Minute), 15*time.Minute) {
+		t.Error("not dispatched 15 minutes ahead")
+	}
+	if err := o.Transition(OrderStatusConfirmed, StatusChange{Actor: &User{ID: "rider", Role: RoleRider}}); err == nil {
+		t.Error("rider released a scheduled order")
+	}
+}
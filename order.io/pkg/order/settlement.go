@@ -0,0 +1,175 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SettlementPolicy configures how the fares are split between the platform
+// and the drivers.
+type SettlementPolicy struct {
+	// Commission is the percentage of the fares the platform keeps when
+	// neither the zone nor the category set one.
+	Commission float64 `json:"commission"`
+}
+
+var DefaultSettlementPolicy = SettlementPolicy{
+	Commission: 20,
+}
+
+func (p SettlementPolicy) Validate() error {
+	if p.Commission < 0 || p.Commission > 100 {
+		return fmt.Errorf("commission must be between 0 and 100: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// CommissionRate returns the commission of the zone, or of the category
+// when the zone sets none, or the default one.
+func (p SettlementPolicy) CommissionRate(zone, category float64) float64 {
+	switch {
+	case zone > 0:
+		return zone
+	case category > 0:
+		return category
+	}
+	return p.Commission
+}
+
+type SettlementStatus string
+
+const (
+	// SettlementStatusPending is a settlement not made yet or waiting for a
+	// retry.
+	SettlementStatusPending SettlementStatus = "PENDING"
+	// SettlementStatusSettled is a settlement applied to the wallet of the
+	// driver.
+	SettlementStatusSettled SettlementStatus = "SETTLED"
+	// SettlementStatusFailed is a settlement out of attempts. It is left to
+	// support.
+	SettlementStatusFailed SettlementStatus = "FAILED"
+)
+
+// Settlement is the split of the fare of a finished ride between the
+// platform and the driver.
+type Settlement struct {
+	Status SettlementStatus `json:"status" bson:"status"`
+	// Fare is the final price before the coupon discount, which the platform
+	// pays.
+	Fare           int     `json:"fare" bson:"fare"`
+	CommissionRate float64 `json:"commission_rate" bson:"commission_rate"`
+	Commission     int     `json:"commission" bson:"commission"`
+	// Earnings is the fare minus the commission.
+	Earnings int `json:"earnings" bson:"earnings"`
+	// Collected is the fare the driver collected in cash.
+	Collected int `json:"collected,omitempty" bson:"collected,omitempty"`
+	// Captured is the fare captured from the wallet of the rider, the only
+	// part of the fare reaching the clearing account of the wallet.
+	Captured int    `json:"captured,omitempty" bson:"captured,omitempty"`
+	Currency string `json:"currency,omitempty" bson:"currency,omitempty"`
+	// ID is the transaction of the settlement in the wallet.
+	ID        string `json:"id,omitempty" bson:"id,omitempty"`
+	Attempts  int    `json:"attempts,omitempty" bson:"attempts,omitempty"`
+	Error     string `json:"error,omitempty" bson:"error,omitempty"`
+	RetryAt   int64  `json:"retry_at,omitempty" bson:"retry_at,omitempty"`
+	SettledAt int64  `json:"settled_at,omitempty" bson:"settled_at,omitempty"`
+}
+
+// Net is the change of the balance of the driver. It is negative when the
+// driver collected in cash more than the earnings and owes the difference.
+func (s *Settlement) Net() int {
+	return s.Earnings - s.Collected
+}
+
+// Settleable reports whether the fare of the order is paid and can be split
+// with the driver.
+func (o *Order) Settleable() bool {
+	return o.Status == OrderStatusDropOff && o.Driver != "" &&
+		(o.ChargeStatus == ChargeStatusSucceeded || o.ChargeStatus == ChargeStatusReceivable)
+}
+
+// Settle computes the split of the fare with the commission rate and leaves
+// the settlement pending.
+func (o *Order) Settle(commissionRate float64) *Settlement {
+	fare := o.Price + o.Discount
+	commission := int(math.Round(float64(fare) * commissionRate / 100))
+	s := &Settlement{
+		Status:         SettlementStatusPending,
+		Fare:           fare,
+		CommissionRate: commissionRate,
+		Commission:     commission,
+		Earnings:       fare - commission,
+		Currency:       o.Currency,
+	}
+	switch {
+	case o.ChargeStatus == ChargeStatusReceivable:
+		s.Collected = o.Price
+	case o.ChargeMethod == ChargeMethodBalance:
+		s.Captured = o.Price
+	}
+	o.Settlement = s
+	return s
+}
+
+// SettlementRequest returns the settlement of the order to apply to the
+// wallet of the driver.
+func (o *Order) SettlementRequest() SettlementRequest {
+	return SettlementRequest{
+		IdempotencyKey: o.ID,
+		Order:          o.ID,
+		Driver:         o.Driver,
+		Fare:           o.Settlement.Fare,
+		Commission:     o.Settlement.Commission,
+		Earnings:       o.Settlement.Earnings,
+		Collected:      o.Settlement.Collected,
+		Captured:       o.Settlement.Captured,
+		Currency:       o.Settlement.Currency,
+	}
+}
+
+// RecordSettlement stores the result of a settlement attempt. Failed
+// attempts are retried like the charges.
+func (o *Order) RecordSettlement(id string, err error, now time.Time) {
+	s := o.Settlement
+	s.Attempts++
+	if err == nil {
+		s.ID = id
+		s.Status = SettlementStatusSettled
+		s.Error = ""
+		s.RetryAt = 0
+		s.SettledAt = now.Unix()
+		return
+	}
+	s.Error = err.Error()
+	if errors.Is(err, ErrInvalidInput) || s.Attempts >= MaxChargeAttempts {
+		s.Status = SettlementStatusFailed
+		s.RetryAt = 0
+		return
+	}
+	s.RetryAt = now.Add(ChargeRetryDelay << (s.Attempts - 1)).Unix()
+}
+
+// SettlementRequest is the settlement of a ride for the wallet of the
+// driver.
+type SettlementRequest struct {
+	// IdempotencyKey identifies the settlement, so a retried settlement is
+	// applied once.
+	IdempotencyKey string
+	Order          string
+	Driver         string
+	Fare           int
+	Commission     int
+	Earnings       int
+	Collected      int
+	Captured       int
+	Currency       string
+}
+
+// Settler applies the settlements to the wallets of the drivers.
+type Settler interface {
+	// Settle returns the transaction of the settlement.
+	Settle(context.Context, SettlementRequest) (string, error)
+}
@@ -0,0 +1,98 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSettlementPolicyCommissionRate(t *testing.T) {
+	p := SettlementPolicy{Commission: 20}
+	tests := []struct {
+		zone, category, want float64
+	}{
+		{0, 0, 20},
+		{0, 15, 15},
+		{25, 15, 25},
+	}
+	for _, tt := range tests {
+		if got := p.CommissionRate(tt.zone, tt.category); got != tt.want {
+			t.Errorf("CommissionRate(%v, %v) = %v, want %v", tt.zone, tt.category, got, tt.want)
+		}
+	}
+	if err := (SettlementPolicy{Commission: 120}).Validate(); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Validate() = %v, want invalid input", err)
+	}
+}
+
+func TestOrderSettle(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  ChargeMethod
+		charge  ChargeStatus
+		want    Settlement
+		wantNet int
+	}{
+		{
+			name:    "paid",
+			method:  ChargeMethodCard,
+			charge:  ChargeStatusSucceeded,
+			want:    Settlement{Fare: 1200, CommissionRate: 20, Commission: 240, Earnings: 960},
+			wantNet: 960,
+		},
+		{
+			name:    "wallet",
+			method:  ChargeMethodBalance,
+			charge:  ChargeStatusSucceeded,
+			want:    Settlement{Fare: 1200, CommissionRate: 20, Commission: 240, Earnings: 960, Captured: 1000},
+			wantNet: 960,
+		},
+		{
+			name:    "cash",
+			method:  ChargeMethodCash,
+			charge:  ChargeStatusReceivable,
+			want:    Settlement{Fare: 1200, CommissionRate: 20, Commission: 240, Earnings: 960, Collected: 1000},
+			wantNet: -40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{ID: "1", Status: OrderStatusDropOff, Driver: "d1", Price: 1000, Discount: 200, ChargeMethod: tt.method, ChargeStatus: tt.charge}
+			if !o.Settleable() {
+				t.Fatal("paid order not settleable")
+			}
+			s := o.Settle(20)
+			if s.Status != SettlementStatusPending || s.Fare != tt.want.Fare || s.Commission != tt.want.Commission ||
+				s.Earnings != tt.want.Earnings || s.Collected != tt.want.Collected || s.Captured != tt.want.Captured {
+				t.Errorf("Settle() = %+v, want %+v", s, tt.want)
+			}
+			if s.Net() != tt.wantNet {
+				t.Errorf("Net() = %v, want %v", s.Net(), tt.wantNet)
+			}
+		})
+	}
+	if (&Order{Status: OrderStatusDropOff, Driver: "d1", ChargeStatus: ChargeStatusFailed}).Settleable() {
+		t.Error("unpaid order settleable")
+	}
+}
+
+func TestOrderRecordSettlement(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	o := &Order{ID: "1", Status: OrderStatusDropOff, Driver: "d1", Price: 1000, ChargeStatus: ChargeStatusSucceeded}
+	o.Settle(20)
+
+	o.RecordSettlement("", errors.New("timeout"), now)
+	if o.Settlement.Status != SettlementStatusPending || o.Settlement.RetryAt != now.Add(ChargeRetryDelay).Unix() {
+		t.Errorf("failed settlement = %+v, want pending retry", o.Settlement)
+	}
+	o.RecordSettlement("t1", nil, now)
+	if o.Settlement.Status != SettlementStatusSettled || o.Settlement.ID != "t1" || o.Settlement.RetryAt != 0 {
+		t.Errorf("settlement = %+v, want settled", o.Settlement)
+	}
+
+	o.Settle(20)
+	o.RecordSettlement("", ErrInvalidInput, now)
+	if o.Settlement.Status != SettlementStatusFailed {
+		t.Errorf("refused settlement = %+v, want failed", o.Settlement)
+	}
+}
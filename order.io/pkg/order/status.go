@@ -0,0 +1,149 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidTransition is returned when an order cannot move from its
+// current status to the requested one.
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// Transition declares a legal status change and the roles allowed to trigger it.
+type Transition struct {
+	From  OrderStatus
+	To    OrderStatus
+	Roles []Role
+}
+
+func (t Transition) allowed(role Role) bool {
+	for _, r := range t.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Transitions is the order state machine. Any status change not listed here
+// is rejected by CanTransition.
+var Transitions = []Transition{
+	{From: OrderStatusNew, To: OrderStatusConfirmed, Roles: []Role{RoleRider}},
+	{From: OrderStatusNew, To: OrderStatusScheduled, Roles: []Role{RoleRider}},
+	{From: OrderStatusScheduled, To: OrderStatusConfirmed, Roles: []Role{RoleAdmin}},
+	{From: OrderStatusConfirmed, To: OrderStatusOnTheWay, Roles: []Role{RoleDriver}},
+	{From: OrderStatusWaitingDriver, To: OrderStatusOnTheWay, Roles: []Role{RoleDriver}},
+	{From: OrderStatusOnTheWay, To: OrderStatusWaitingDriver, Roles: []Role{RoleDriver}},
+	{From: OrderStatusOnTheWay, To: OrderStatusArrived, Roles: []Role{RoleDriver, RoleAdmin}},
+	{From: OrderStatusOnTheWay, To: OrderStatusPickUp, Roles: []Role{RoleDriver, RoleAdmin}},
+	{From: OrderStatusArrived, To: OrderStatusWaitingDriver, Roles: []Role{RoleDriver}},
+	{From: OrderStatusArrived, To: OrderStatusPickUp, Roles: []Role{RoleDriver, RoleAdmin}},
+	{From: OrderStatusPickUp, To: OrderStatusDropOff, Roles: []Role{RoleDriver, RoleAdmin}},
+	{From: OrderStatusNew, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusScheduled, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusConfirmed, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusWaitingDriver, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusOnTheWay, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusArrived, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusPickUp, To: OrderStatusCancel, Roles: []Role{RoleRider, RoleAdmin}},
+	{From: OrderStatusConfirmed, To: OrderStatusNoDriver, Roles: []Role{RoleAdmin}},
+	{From: OrderStatusWaitingDriver, To: OrderStatusNoDriver, Roles: []Role{RoleAdmin}},
+}
+
+// TransitionError describes a rejected status change.
+type TransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+	Role Role
+
+	// Err is ErrAccessDenied when the transition exists but the role may not
+	// trigger it, ErrInvalidTransition otherwise.
+	Err error
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("order cannot move from %s to %s as %s: %v", e.From, e.To, e.Role, e.Err)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Err
+}
+
+// CanTransition reports whether role may move an order from one status to another.
+func CanTransition(from, to OrderStatus, role Role) error {
+	for _, t := range Transitions {
+		if t.From != from || t.To != to {
+			continue
+		}
+		if !t.allowed(role) {
+			return &TransitionError{From: from, To: to, Role: role, Err: ErrAccessDenied}
+		}
+		return nil
+	}
+	return &TransitionError{From: from, To: to, Role: role, Err: ErrInvalidTransition}
+}
+
+// IsTerminal reports whether no further transitions are possible from the status.
+func (e OrderStatus) IsTerminal() bool {
+	for _, t := range Transitions {
+		if t.From == e {
+			return false
+		}
+	}
+	return true
+}
+
+// StatusChange carries who triggered a status transition and why.
+type StatusChange struct {
+	Actor    *User
+	Reason   string
+	Location *Point
+}
+
+// Transition validates and applies a status change, recording it in the
+// order status history.
+func (o *Order) Transition(to OrderStatus, change StatusChange) error {
+	if change.Actor == nil {
+		return ErrNilUserInContext
+	}
+	if err := CanTransition(o.Status, to, change.Actor.Role); err != nil {
+		return err
+	}
+	o.StatusHistory = append(o.StatusHistory, &OrderStatusHistory{
+		ID:        NewID().String(),
+		Status:    to,
+		Previous:  o.Status,
+		Actor:     change.Actor.ID,
+		ActorRole: change.Actor.Role,
+		Reason:    change.Reason,
+		Location:  change.Location,
+		ChangedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	o.Status = to
+	return nil
+}
+
+// Timeline returns a page of the order status history, oldest first,
+// starting after the entry whose ID matches the filter token.
+func (o *Order) Timeline(filter StatusHistoryFilter) *StatusHistoryList {
+	start := 0
+	if filter.Token != "" {
+		start = len(o.StatusHistory)
+		for i, h := range o.StatusHistory {
+			if h.ID == filter.Token {
+				start = i + 1
+				break
+			}
+		}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 10
+	}
+	list := &StatusHistoryList{Data: o.StatusHistory[start:]}
+	if len(list.Data) > filter.Limit {
+		list.Data = list.Data[:filter.Limit]
+		list.Token = list.Data[filter.Limit-1].ID
+	}
+	return list
+}
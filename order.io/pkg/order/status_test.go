@@ -0,0 +1,106 @@
+package order
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    OrderStatus
+		to      OrderStatus
+		role    Role
+		wantErr error
+	}{
+		{
+			name: "rider confirms a new order",
+			from: OrderStatusNew,
+			to:   OrderStatusConfirmed,
+			role: RoleRider,
+		},
+		{
+			name: "driver accepts a confirmed order",
+			from: OrderStatusConfirmed,
+			to:   OrderStatusOnTheWay,
+			role: RoleDriver,
+		},
+		{
+			name:    "driver finishes an order never started",
+			from:    OrderStatusOnTheWay,
+			to:      OrderStatusDropOff,
+			role:    RoleDriver,
+			wantErr: ErrInvalidTransition,
+		},
+		{
+			name:    "rider cannot start a trip",
+			from:    OrderStatusOnTheWay,
+			to:      OrderStatusPickUp,
+			role:    RoleRider,
+			wantErr: ErrAccessDenied,
+		},
+		{
+			name:    "finished order is terminal",
+			from:    OrderStatusDropOff,
+			to:      OrderStatusCancel,
+			role:    RoleAdmin,
+			wantErr: ErrInvalidTransition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanTransition(tt.from, tt.to, tt.role)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("CanTransition() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CanTransition() error = %v, want %v", err, tt.wantErr)
+			}
+			var terr *TransitionError
+			if !errors.As(err, &terr) {
+				t.Fatalf("CanTransition() error = %T, want *TransitionError", err)
+			}
+		})
+	}
+}
+
+func TestOrderTransition(t *testing.T) {
+	o := &Order{Status: OrderStatusNew}
+	if err := o.Transition(OrderStatusConfirmed, StatusChange{Actor: &User{ID: "rider", Role: RoleRider}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Transition(OrderStatusDropOff, StatusChange{Actor: &User{ID: "driver", Role: RoleDriver}}); err == nil {
+		t.Fatal("expected error finishing a confirmed order")
+	}
+	if o.Status != OrderStatusConfirmed {
+		t.Errorf("Status = %v, want %v", o.Status, OrderStatusConfirmed)
+	}
+	if len(o.StatusHistory) != 1 {
+		t.Fatalf("StatusHistory = %v, want a single entry", o.StatusHistory)
+	}
+	h := o.StatusHistory[0]
+	if h.Status != OrderStatusConfirmed || h.Previous != OrderStatusNew || h.Actor != "rider" || h.ActorRole != RoleRider {
+		t.Errorf("StatusHistory[0] = %+v", h)
+	}
+	if !OrderStatusDropOff.IsTerminal() || OrderStatusNew.IsTerminal() {
+		t.Error("IsTerminal() mismatch")
+	}
+}
+
+func TestOrderTimeline(t *testing.T) {
+	o := &Order{}
+	for _, id := range []string{"a", "b", "c"} {
+		o.StatusHistory = append(o.StatusHistory, &OrderStatusHistory{ID: id})
+	}
+	page := o.Timeline(StatusHistoryFilter{Limit: 2})
+	if len(page.Data) != 2 || page.Token != "b" {
+		t.Fatalf("first page = %d entries, token %q", len(page.Data), page.Token)
+	}
+	page = o.Timeline(StatusHistoryFilter{Limit: 2, Token: page.Token})
+	if len(page.Data) != 1 || page.Data[0].ID != "c" || page.Token != "" {
+		t.Fatalf("second page = %v, token %q", page.Data, page.Token)
+	}
+}
@@ -0,0 +1,167 @@
+package order
+
+import (
+	"fmt"
+	"time"
+)
+
+type StopStatus string
+
+const (
+	StopStatusPending  StopStatus = "PENDING"
+	StopStatusArrived  StopStatus = "ARRIVED"
+	StopStatusDeparted StopStatus = "DEPARTED"
+	StopStatusSkipped  StopStatus = "SKIPPED"
+)
+
+// Stop is an intermediate point of the route between the pickup and the
+// drop off.
+type Stop struct {
+	ID     string     `json:"id" bson:"id"`
+	Point  *Point     `json:"point" bson:"point"`
+	Status StopStatus `json:"status" bson:"status"`
+	// Distance in meters and duration in seconds of the leg ending at the stop.
+	Distance   float64 `json:"distance" bson:"distance"`
+	Duration   float64 `json:"duration" bson:"duration"`
+	ArrivedAt  int64   `json:"arrived_at,omitempty" bson:"arrived_at,omitempty"`
+	DepartedAt int64   `json:"departed_at,omitempty" bson:"departed_at,omitempty"`
+}
+
+func (s *Stop) visited() bool {
+	return s.Status == StopStatusArrived || s.Status == StopStatusDeparted
+}
+
+// SyncStops rebuilds the stops from the intermediate points of the order and
+// the legs of its route. Stops already in the order keep their status.
+func (o *Order) SyncStops() {
+	existing := make(map[Point]*Stop, len(o.Stops))
+	for _, s := range o.Stops {
+		existing[Point{Lat: s.Point.Lat, Lng: s.Point.Lng}] = s
+	}
+	var stops []*Stop
+	for i := 1; i < len(o.Item.Points)-1; i++ {
+		p := o.Item.Points[i]
+		stop, ok := existing[Point{Lat: p.Lat, Lng: p.Lng}]
+		if !ok {
+			stop = &Stop{ID: NewID().String(), Point: p, Status: StopStatusPending}
+		}
+		stop.Distance, stop.Duration = 0, 0
+		if o.Route != nil && i-1 < len(o.Route.Legs) {
+			stop.Distance = o.Route.Legs[i-1].Distance
+			stop.Duration = o.Route.Legs[i-1].Duration
+		}
+		stops = append(stops, stop)
+	}
+	o.Stops = stops
+}
+
+func (o *Order) stop(id string) (int, *Stop, error) {
+	for i, s := range o.Stops {
+		if s.ID == id {
+			return i, s, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("stop %s: %w", id, ErrNotFound)
+}
+
+// StopArrived records the driver arrived to the stop. The driver must have
+// departed the previous stop; pending stops before it are skipped.
+func (o *Order) StopArrived(id string, now time.Time) error {
+	if o.Status != OrderStatusPickUp {
+		return fmt.Errorf("order %s is %s: %w", o.ID, o.Status, ErrInvalidTransition)
+	}
+	i, stop, err := o.stop(id)
+	if err != nil {
+		return err
+	}
+	if stop.Status != StopStatusPending {
+		return fmt.Errorf("stop %s is %s: %w", id, stop.Status, ErrInvalidTransition)
+	}
+	for _, prev := range o.Stops[:i] {
+		if prev.Status == StopStatusArrived {
+			return fmt.Errorf("stop %s was not departed: %w", prev.ID, ErrInvalidTransition)
+		}
+	}
+	for _, prev := range o.Stops[:i] {
+		if prev.Status == StopStatusPending {
+			prev.Status = StopStatusSkipped
+		}
+	}
+	stop.Status = StopStatusArrived
+	stop.ArrivedAt = now.Unix()
+	return nil
+}
+
+// StopDeparted records the driver left the stop.
+func (o *Order) StopDeparted(id string, now time.Time) error {
+	if o.Status != OrderStatusPickUp {
+		return fmt.Errorf("order %s is %s: %w", o.ID, o.Status, ErrInvalidTransition)
+	}
+	_, stop, err := o.stop(id)
+	if err != nil {
+		return err
+	}
+	if stop.Status != StopStatusArrived {
+		return fmt.Errorf("stop %s is %s: %w", id, stop.Status, ErrInvalidTransition)
+	}
+	stop.Status = StopStatusDeparted
+	stop.DepartedAt = now.Unix()
+	return nil
+}
+
+// AddStop inserts a point at the position among the stops, or before the
+// drop off when position is negative or past the last stop. Stops cannot be
+// added before a visited stop.
+func (o *Order) AddStop(p *Point, position int) error {
+	if o.Status.IsTerminal() {
+		return fmt.Errorf("order %s is %s: %w", o.ID, o.Status, ErrInvalidTransition)
+	}
+	if !p.Valid() {
+		return NewInvalidParameter("point", p)
+	}
+	if len(o.Item.Points) < 2 {
+		return fmt.Errorf("order %s has no drop off: %w", o.ID, ErrInvalidInput)
+	}
+	if position < 0 || position > len(o.Stops) {
+		position = len(o.Stops)
+	}
+	for _, s := range o.Stops[position:] {
+		if s.visited() {
+			return fmt.Errorf("stop cannot be added before the visited stop %s: %w", s.ID, ErrInvalidInput)
+		}
+	}
+	at := position + 1
+	points := make([]*Point, 0, len(o.Item.Points)+1)
+	points = append(points, o.Item.Points[:at]...)
+	points = append(points, p)
+	points = append(points, o.Item.Points[at:]...)
+	o.Item.Points = points
+	return nil
+}
+
+// RemoveStop removes a stop not visited yet.
+func (o *Order) RemoveStop(id string) error {
+	if o.Status.IsTerminal() {
+		return fmt.Errorf("order %s is %s: %w", o.ID, o.Status, ErrInvalidTransition)
+	}
+	i, stop, err := o.stop(id)
+	if err != nil {
+		return err
+	}
+	if stop.visited() {
+		return fmt.Errorf("stop %s is %s: %w", id, stop.Status, ErrInvalidTransition)
+	}
+	at := i + 1
+	o.Item.Points = append(o.Item.Points[:at:at], o.Item.Points[at+1:]...)
+	o.Stops = append(o.Stops[:i:i], o.Stops[i+1:]...)
+	return nil
+}
+
+// SkipPendingStops marks the stops not visited when the trip ends.
+func (o *Order) SkipPendingStops() {
+	for _, s := range o.Stops {
+		if s.Status == StopStatusPending {
+			s.Status = StopStatusSkipped
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOrderStops(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	o := &Order{
+		Status: OrderStatusPickUp,
+		Item: Item{Points: []*Point{
+			{Lat: 23.10, Lng: -82.30},
+			{Lat: 23.11, Lng: -82.31},
+			{Lat: 23.12, Lng: -82.32},
+			{Lat: 23.13, Lng: -82.33},
+		}},
+		Route: &Route{Legs: []*Legs{{Distance: 100}, {Distance: 200}, {Distance: 300}}},
+	}
+	o.SyncStops()
+	if len(o.Stops) != 2 || o.Stops[0].Distance != 100 || o.Stops[1].Distance != 200 {
+		t.Fatalf("SyncStops() = %+v", o.Stops)
+	}
+	first, second := o.Stops[0].ID, o.Stops[1].ID
+
+	if err := o.StopDeparted(first, now); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("departed before arriving: %v", err)
+	}
+	if err := o.StopArrived(first, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.StopArrived(second, now); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("arrived while at the previous stop: %v", err)
+	}
+	if err := o.StopDeparted(first, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := o.AddStop(&Point{Lat: 23.115, Lng: -82.315}, 0); err == nil {
+		t.Fatal("added a stop before a visited stop")
+	}
+	if err := o.AddStop(&Point{Lat: 23.125, Lng: -82.325}, -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.RemoveStop(first); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("removed a visited stop: %v", err)
+	}
+	if err := o.RemoveStop(second); err != nil {
+		t.Fatal(err)
+	}
+	o.SyncStops()
+	if len(o.Item.Points) != 4 || len(o.Stops) != 2 {
+		t.Fatalf("points = %d, stops = %d, want 4 and 2", len(o.Item.Points), len(o.Stops))
+	}
+	if o.Stops[0].ID != first || o.Stops[0].Status != StopStatusDeparted || o.Stops[1].Status != StopStatusPending {
+		t.Errorf("stops after changes = %+v, %+v", o.Stops[0], o.Stops[1])
+	}
+	o.SkipPendingStops()
+	if o.Stops[1].Status != StopStatusSkipped {
+		t.Errorf("pending stop status = %v, want skipped", o.Stops[1].Status)
+	}
+}
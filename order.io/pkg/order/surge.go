@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// SurgePolicy configures the surge multiplier computed for each cell of a
+// grid from the open orders and the available drivers in it.
+type SurgePolicy struct {
+	// CellSize is the side of a cell in degrees.
+	CellSize float64 `json:"cell_size"`
+	// Sensitivity is how much the multiplier grows for each open order above
+	// one per available driver.
+	Sensitivity float64 `json:"sensitivity"`
+	// MaxMultiplier caps the multiplier.
+	MaxMultiplier float64 `json:"max_multiplier"`
+	// Smoothing is the weight in (0, 1] of the new demand against the last
+	// multiplier, so prices do not jump between updates.
+	Smoothing float64 `json:"smoothing"`
+}
+
+// DefaultSurgePolicy uses cells of about 2 km.
+var DefaultSurgePolicy = SurgePolicy{
+	CellSize:      0.02,
+	Sensitivity:   0.5,
+	MaxMultiplier: 3,
+	Smoothing:     0.3,
+}
+
+func (p SurgePolicy) Validate() error {
+	if p.CellSize <= 0 {
+		return fmt.Errorf("cell size must be positive: %w", ErrInvalidInput)
+	}
+	if p.Sensitivity < 0 {
+		return fmt.Errorf("sensitivity must not be negative: %w", ErrInvalidInput)
+	}
+	if p.MaxMultiplier < 1 {
+		return fmt.Errorf("max multiplier must be at least 1: %w", ErrInvalidInput)
+	}
+	if p.Smoothing <= 0 || p.Smoothing > 1 {
+		return fmt.Errorf("smoothing must be in (0, 1]: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// Cell returns the key of the grid cell of the point.
+func (p SurgePolicy) Cell(pt *Point) string {
+	return fmt.Sprintf("%d:%d", int(math.Floor(pt.Lat/p.CellSize)), int(math.Floor(pt.Lng/p.CellSize)))
+}
+
+// Target returns the multiplier for the demand and supply of a cell, without
+// smoothing.
+func (p SurgePolicy) Target(demand, supply int) float64 {
+	ratio := float64(demand) / float64(max(supply, 1))
+	if ratio <= 1 {
+		return 1
+	}
+	return math.Min(1+(ratio-1)*p.Sensitivity, p.MaxMultiplier)
+}
+
+// Next returns the multiplier of a cell moved from the previous one toward
+// the target of the demand and supply, rounded to hundredths.
+func (p SurgePolicy) Next(previous float64, demand, supply int) float64 {
+	if previous < 1 {
+		previous = 1
+	}
+	next := previous + p.Smoothing*(p.Target(demand, supply)-previous)
+	return math.Min(math.Max(math.Round(next*100)/100, 1), p.MaxMultiplier)
+}
+
+// Surge is the multiplier applied to the prices of the rides picked up in a
+// cell.
+type Surge struct {
+	Cell       string  `json:"cell"`
+	Multiplier float64 `json:"multiplier"`
+	Demand     int     `json:"demand,omitempty"`
+	Supply     int     `json:"supply,omitempty"`
+	// Override is set by an admin and replaces the computed multiplier until
+	// ExpiresAt.
+	Override  bool  `json:"override,omitempty"`
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// Active reports whether the surge raises prices.
+func (s *Surge) Active() bool {
+	return s != nil && s.Multiplier > 1
+}
+
+type SurgeService interface {
+	// Surge returns the multiplier of the cell of the point.
+	Surge(context.Context, Point) (*Surge, error)
+	// Surges returns the cells with an active surge or override. This is only
+	// available to the admin.
+	Surges(context.Context) ([]*Surge, error)
+	// SetSurgeOverride fixes the multiplier of the cell of the point until
+	// the expiration, in unix seconds. This is only available to the admin.
+	SetSurgeOverride(context.Context, Point, float64, int64) (*Surge, error)
+	// ClearSurgeOverride removes the override of the cell of the point. This
+	// is only available to the admin.
+	ClearSurgeOverride(context.Context, Point) error
+}
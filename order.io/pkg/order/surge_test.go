@@ -0,0 +1,53 @@
+package order
+
+import "testing"
+
+func TestSurgePolicyTarget(t *testing.T) {
+	policy := SurgePolicy{CellSize: 0.02, Sensitivity: 0.5, MaxMultiplier: 2, Smoothing: 0.5}
+	tests := []struct {
+		name           string
+		demand, supply int
+		want           float64
+	}{
+		{"no demand", 0, 5, 1},
+		{"balanced", 5, 5, 1},
+		{"twice the drivers", 10, 5, 1.5},
+		{"no drivers", 3, 0, 2},
+		{"capped", 50, 5, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Target(tt.demand, tt.supply); got != tt.want {
+				t.Errorf("SurgePolicy.Target() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurgePolicyNext(t *testing.T) {
+	policy := SurgePolicy{CellSize: 0.02, Sensitivity: 0.5, MaxMultiplier: 3, Smoothing: 0.5}
+	// the target is 2 and the multiplier moves half way on every update
+	m := policy.Next(0, 15, 5)
+	if m != 1.5 {
+		t.Fatalf("first Next() = %v, want 1.5", m)
+	}
+	if m = policy.Next(m, 15, 5); m != 1.75 {
+		t.Fatalf("second Next() = %v, want 1.75", m)
+	}
+	if m = policy.Next(m, 0, 5); m != 1.38 {
+		t.Fatalf("Next() without demand = %v, want 1.38", m)
+	}
+}
+
+func TestSurgePolicyCell(t *testing.T) {
+	policy := SurgePolicy{CellSize: 0.02}
+	a := policy.Cell(&Point{Lat: 23.1131, Lng: -82.3661})
+	b := policy.Cell(&Point{Lat: 23.1139, Lng: -82.3669})
+	c := policy.Cell(&Point{Lat: 23.1531, Lng: -82.3661})
+	if a != b {
+		t.Errorf("near points in different cells: %s, %s", a, b)
+	}
+	if a == c {
+		t.Errorf("far points in the same cell: %s", a)
+	}
+}
@@ -0,0 +1,86 @@
+package order
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+const (
+	// trackMinDistance is the distance in meters a driver must move before a
+	// new breadcrumb is kept.
+	trackMinDistance = 15
+	// trackMaxInterval keeps a breadcrumb after this many seconds even if the
+	// driver did not move, so stops remain visible in the trip path.
+	trackMaxInterval = 60
+	// trackMaxSpeed drops GPS spikes implying a speed above it, in m/s.
+	trackMaxSpeed = 55
+	// trackClockSkew is how far in the future a breadcrumb timestamp may be.
+	trackClockSkew = 30
+)
+
+// Track merges the breadcrumbs sent by the driver into the trip path.
+// Points are ordered by timestamp, so late batches land in place. Every new
+// point is checked against the accepted point before it, or the pickup for
+// the first one, and dropped when outside the trip, duplicated, too close to
+// it or implying an impossible speed. Stored points are never dropped. It
+// returns how many points the path grew by.
+func (o *Order) Track(points []*Point, now time.Time) int {
+	var incoming []*Point
+	for _, p := range points {
+		if p == nil || !p.Valid() || p.Timestamp < o.StartAt || p.Timestamp > now.Unix()+trackClockSkew {
+			continue
+		}
+		incoming = append(incoming, p)
+	}
+	sort.SliceStable(incoming, func(i, j int) bool {
+		return incoming[i].Timestamp < incoming[j].Timestamp
+	})
+
+	added := 0
+	for _, p := range incoming {
+		i := sort.Search(len(o.History), func(i int) bool {
+			return o.History[i].Timestamp >= p.Timestamp
+		})
+		if i < len(o.History) && o.History[i].Timestamp == p.Timestamp {
+			continue
+		}
+		if i > 0 {
+			prev := o.History[i-1]
+			if prev.Distance(p) < trackMinDistance && p.Timestamp-prev.Timestamp < trackMaxInterval {
+				continue
+			}
+			if trackTooFast(prev, p) {
+				continue
+			}
+		} else if pickup := o.trackPickup(); pickup != nil && trackTooFast(pickup, p) {
+			continue
+		}
+		if i < len(o.History) && trackTooFast(p, o.History[i]) {
+			continue
+		}
+		o.History = slices.Insert(o.History, i, p)
+		added++
+	}
+	return added
+}
+
+// trackPickup is where the trip path starts, the pickup at the start time.
+func (o *Order) trackPickup() *Point {
+	if len(o.Item.Points) == 0 || o.Item.Points[0] == nil {
+		return nil
+	}
+	pickup := *o.Item.Points[0]
+	pickup.Timestamp = o.StartAt
+	return &pickup
+}
+
+// trackTooFast reports whether moving between the points implies a speed
+// above trackMaxSpeed.
+func trackTooFast(from, to *Point) bool {
+	dt := to.Timestamp - from.Timestamp
+	if dt < 1 {
+		dt = 1
+	}
+	return from.Distance(to)/float64(dt) > trackMaxSpeed
+}
@@ -0,0 +1,65 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderTrack(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	start := now.Unix() - 600
+	// 0.001 degrees of latitude is about 111 meters
+	at := func(step int, seconds int64) *Point {
+		return &Point{Lat: 23 + float64(step)*0.001, Lng: -82, Timestamp: start + seconds}
+	}
+	o := &Order{StartAt: start}
+
+	if got := o.Track([]*Point{at(0, 0), at(1, 10), at(2, 20)}, now); got != 3 {
+		t.Fatalf("first batch kept %d points, want 3", got)
+	}
+	// duplicate and late point arriving out of order
+	if got := o.Track([]*Point{at(2, 20), at(1, 15), at(3, 30)}, now); got != 1 {
+		t.Fatalf("second batch kept %d points, want 1", got)
+	}
+	// GPS spike, point before the trip and point in the future
+	if got := o.Track([]*Point{at(100, 40), at(4, -10), at(4, 700)}, now); got != 0 {
+		t.Fatalf("third batch kept %d points, want 0", got)
+	}
+	for i := 1; i < len(o.History); i++ {
+		if o.History[i].Timestamp <= o.History[i-1].Timestamp {
+			t.Fatalf("history not ordered at %d: %v", i, o.History)
+		}
+	}
+	if last := o.History[len(o.History)-1]; last.Timestamp != start+30 {
+		t.Errorf("last breadcrumb at %d, want %d", last.Timestamp, start+30)
+	}
+}
+
+func TestOrderTrackKeepsStoredPoints(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	start := now.Unix() - 600
+	at := func(step float64, seconds int64) *Point {
+		return &Point{Lat: 23 + step*0.001, Lng: -82, Timestamp: start + seconds}
+	}
+	o := &Order{StartAt: start, Item: Item{Points: []*Point{at(0, 0)}}}
+
+	// GPS spike as the first point is checked against the pickup
+	if got := o.Track([]*Point{at(100, 5)}, now); got != 0 {
+		t.Fatalf("spike kept %d points, want 0", got)
+	}
+	if got := o.Track([]*Point{at(0, 0), at(1, 10), at(2, 20)}, now); got != 3 {
+		t.Fatalf("first batch kept %d points, want 3", got)
+	}
+	// late point close to a stored one does not drop it
+	if got := o.Track([]*Point{at(0.9, 5)}, now); got != 1 {
+		t.Fatalf("late batch kept %d points, want 1", got)
+	}
+	if len(o.History) != 4 {
+		t.Fatalf("history has %d points, want 4", len(o.History))
+	}
+	for i := 1; i < len(o.History); i++ {
+		if o.History[i].Timestamp <= o.History[i-1].Timestamp {
+			t.Fatalf("history not ordered at %d: %v", i, o.History)
+		}
+	}
+}
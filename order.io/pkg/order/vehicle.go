@@ -56,3 +56,40 @@ func (e *VehicleCategory) UnmarshalGQL(v interface{}) error {
 func (e VehicleCategory) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// xlSeats is the number of seats a vehicle needs to serve XL orders.
+const xlSeats = 6
+
+// DriverVehicle is the active vehicle of a driver as tagged in the realtime
+// driver index.
+type DriverVehicle struct {
+	Category   VehicleCategory `json:"category"`
+	Seats      int             `json:"seats"`
+	Facilities []string        `json:"facilities,omitempty"`
+}
+
+// Serves reports whether the vehicle can serve orders of the category. Any
+// passenger vehicle serves X orders and the ones with enough seats serve XL
+// orders too.
+func (v *DriverVehicle) Serves(category VehicleCategory) bool {
+	switch {
+	case v.Category == category:
+		return true
+	case v.Category == VehicleCategoryPackage:
+		return false
+	case category == VehicleCategoryX:
+		return true
+	case category == VehicleCategoryXl:
+		return v.Seats >= xlSeats
+	}
+	return false
+}
+
+// Eligible reports whether the vehicle can serve the order category and
+// riders.
+func (v *DriverVehicle) Eligible(o *Order) bool {
+	if o.SelectedCategory != nil && !v.Serves(o.SelectedCategory.Category) {
+		return false
+	}
+	return v.Seats == 0 || o.Item.Riders <= v.Seats
+}
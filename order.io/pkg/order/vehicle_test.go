@@ -0,0 +1,70 @@
+package order
+
+import "testing"
+
+func TestDriverVehicleEligible(t *testing.T) {
+	tests := []struct {
+		name     string
+		vehicle  DriverVehicle
+		category VehicleCategory
+		riders   int
+		want     bool
+	}{
+		{
+			name:     "same category",
+			vehicle:  DriverVehicle{Category: VehicleCategoryPets, Seats: 4},
+			category: VehicleCategoryPets,
+			riders:   1,
+			want:     true,
+		},
+		{
+			name:     "any passenger vehicle serves X",
+			vehicle:  DriverVehicle{Category: VehicleCategoryConfort, Seats: 4},
+			category: VehicleCategoryX,
+			riders:   2,
+			want:     true,
+		},
+		{
+			name:     "X vehicle does not serve pets",
+			vehicle:  DriverVehicle{Category: VehicleCategoryX, Seats: 4},
+			category: VehicleCategoryPets,
+			riders:   1,
+		},
+		{
+			name:     "XL needs enough seats",
+			vehicle:  DriverVehicle{Category: VehicleCategoryX, Seats: 4},
+			category: VehicleCategoryXl,
+			riders:   1,
+		},
+		{
+			name:     "large vehicle serves XL",
+			vehicle:  DriverVehicle{Category: VehicleCategoryX, Seats: 7},
+			category: VehicleCategoryXl,
+			riders:   6,
+			want:     true,
+		},
+		{
+			name:     "package vehicle does not carry riders",
+			vehicle:  DriverVehicle{Category: VehicleCategoryPackage, Seats: 2},
+			category: VehicleCategoryX,
+			riders:   1,
+		},
+		{
+			name:     "too many riders",
+			vehicle:  DriverVehicle{Category: VehicleCategoryX, Seats: 4},
+			category: VehicleCategoryX,
+			riders:   5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{
+				Item:             Item{Riders: tt.riders},
+				SelectedCategory: &CategoryPrice{Category: tt.category},
+			}
+			if got := tt.vehicle.Eligible(o); got != tt.want {
+				t.Errorf("Eligible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package order
+
+import (
+	"fmt"
+	"time"
+)
+
+// WaitingPolicy defines how long a driver waits for free at the pickup point
+// and the price of every extra minute. It is configured per rate and copied to
+// the order when the price is calculated.
+type WaitingPolicy struct {
+	FreeMinutes int `json:"free_minutes,omitempty" bson:"free_minutes,omitempty"`
+	PricePerMin int `json:"price_per_min,omitempty" bson:"price_per_min,omitempty"`
+}
+
+func (p *WaitingPolicy) Validate() error {
+	if p.FreeMinutes < 0 {
+		return fmt.Errorf("free waiting minutes must be positive: %w", ErrInvalidInput)
+	}
+	if p.PricePerMin < 0 {
+		return fmt.Errorf("price per waiting minute must be positive: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// BillableWait returns the waiting minutes beyond the free allowance since
+// the driver arrived, and what they cost. Started minutes are billed.
+func (o *Order) BillableWait(now time.Time) (minutes, charge int) {
+	if o.ArrivedAt == 0 || o.WaitingPolicy == nil {
+		return 0, 0
+	}
+	waited := now.Unix() - o.ArrivedAt
+	if waited <= 0 {
+		return 0, 0
+	}
+	minutes = int((waited+59)/60) - o.WaitingPolicy.FreeMinutes
+	if minutes <= 0 {
+		return 0, 0
+	}
+	return minutes, minutes * o.WaitingPolicy.PricePerMin
+}
@@ -0,0 +1,40 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderBillableWait(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	policy := &WaitingPolicy{FreeMinutes: 3, PricePerMin: 500}
+	tests := []struct {
+		name        string
+		order       Order
+		wantMinutes int
+		wantCharge  int
+	}{
+		{
+			name:  "driver never arrived",
+			order: Order{WaitingPolicy: policy},
+		},
+		{
+			name:  "inside the free allowance",
+			order: Order{ArrivedAt: now.Unix() - 150, WaitingPolicy: policy},
+		},
+		{
+			name:        "started minutes are billed",
+			order:       Order{ArrivedAt: now.Unix() - 301, WaitingPolicy: policy},
+			wantMinutes: 3,
+			wantCharge:  1500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minutes, charge := tt.order.BillableWait(now)
+			if minutes != tt.wantMinutes || charge != tt.wantCharge {
+				t.Errorf("Order.BillableWait() = %v, %v, want %v, %v", minutes, charge, tt.wantMinutes, tt.wantCharge)
+			}
+		})
+	}
+}
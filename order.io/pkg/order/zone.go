@@ -0,0 +1,209 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// ErrNoService is returned for rides starting outside of the service areas
+// or going through a no service area.
+var ErrNoService = errors.New("no service in the area")
+
+type ZoneType string
+
+const (
+	// ZoneTypeServiceArea is where rides may start. Without service areas
+	// rides may start anywhere.
+	ZoneTypeServiceArea ZoneType = "SERVICE_AREA"
+	// ZoneTypePricing is an area with its own rates or surcharges, such as an
+	// airport or a city zone.
+	ZoneTypePricing ZoneType = "PRICING"
+	// ZoneTypeNoService is where rides cannot start, stop or end.
+	ZoneTypeNoService ZoneType = "NO_SERVICE"
+)
+
+func (e ZoneType) IsValid() bool {
+	switch e {
+	case ZoneTypeServiceArea, ZoneTypePricing, ZoneTypeNoService:
+		return true
+	}
+	return false
+}
+
+// Zone is an area defined by a polygon.
+type Zone struct {
+	ID   string   `json:"id" bson:"_id"`
+	Name string   `json:"name" bson:"name"`
+	Type ZoneType `json:"type" bson:"type"`
+	// Polygon is the boundary of the zone. The last point connects to the
+	// first one.
+	Polygon []*Point `json:"polygon" bson:"polygon"`
+	// Rates are the codes of the rates of the rides picked up in the zone.
+	Rates []string `json:"rates,omitempty" bson:"rates,omitempty"`
+	// PickupSurcharge and DropoffSurcharge are added to the price of the rides
+	// picked up or dropped off in the zone.
+	PickupSurcharge  int `json:"pickup_surcharge,omitempty" bson:"pickup_surcharge,omitempty"`
+	DropoffSurcharge int `json:"dropoff_surcharge,omitempty" bson:"dropoff_surcharge,omitempty"`
+	// Priority breaks the tie between overlapping zones with rates, the
+	// highest wins.
+	Priority int  `json:"priority,omitempty" bson:"priority,omitempty"`
+	Disabled bool `json:"disabled,omitempty" bson:"disabled,omitempty"`
+	// Commission is the percentage of the fares of the rides picked up in the
+	// zone the platform keeps, over the one of the category. Zero is not set.
+	Commission float64 `json:"commission,omitempty" bson:"commission,omitempty"`
+}
+
+func (z *Zone) Validate() error {
+	if z.Name == "" {
+		return fmt.Errorf("name is required: %w", ErrInvalidInput)
+	}
+	if !z.Type.IsValid() {
+		return fmt.Errorf("invalid zone type %q: %w", z.Type, ErrInvalidInput)
+	}
+	if len(z.Polygon) < 3 {
+		return fmt.Errorf("polygon needs at least 3 points: %w", ErrInvalidInput)
+	}
+	for _, p := range z.Polygon {
+		if p == nil || !p.Valid() {
+			return fmt.Errorf("invalid polygon point %v: %w", p, ErrInvalidInput)
+		}
+	}
+	if z.PickupSurcharge < 0 || z.DropoffSurcharge < 0 {
+		return fmt.Errorf("surcharges must not be negative: %w", ErrInvalidInput)
+	}
+	if z.Commission < 0 || z.Commission > 100 {
+		return fmt.Errorf("commission must be between 0 and 100: %w", ErrInvalidInput)
+	}
+	if z.Type == ZoneTypeNoService && (len(z.Rates) > 0 || z.PickupSurcharge > 0 || z.DropoffSurcharge > 0) {
+		return fmt.Errorf("no service zones have no rates nor surcharges: %w", ErrInvalidInput)
+	}
+	return nil
+}
+
+// Contains reports whether the point is inside the polygon of the zone.
+func (z *Zone) Contains(p *Point) bool {
+	inside := false
+	n := len(z.Polygon)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		a, b := z.Polygon[i], z.Polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// ZoneMatch is how the zones apply to a route.
+type ZoneMatch struct {
+	// Zone is the zone of the pickup point with rates, if any.
+	Zone *Zone
+	// Surcharge is the sum of the surcharges of the pickup and drop off
+	// zones.
+	Surcharge int
+}
+
+// MatchZones checks the route is served and returns the pricing of the zones
+// it starts and ends in. The first point is the pickup and the last one the
+// drop off.
+func MatchZones(zones []*Zone, points []*Point) (*ZoneMatch, error) {
+	match := &ZoneMatch{}
+	if len(points) == 0 {
+		return match, nil
+	}
+	pickup, dropoff := points[0], points[len(points)-1]
+	var areas, served bool
+	var priced []*Zone
+	for _, z := range zones {
+		if z.Disabled {
+			continue
+		}
+		switch z.Type {
+		case ZoneTypeNoService:
+			for _, p := range points {
+				if z.Contains(p) {
+					return nil, noService("point", fmt.Sprintf("%s is not served", z.Name))
+				}
+			}
+			continue
+		case ZoneTypeServiceArea:
+			areas = true
+			if z.Contains(pickup) {
+				served = true
+			}
+		}
+		if z.Contains(pickup) {
+			match.Surcharge += z.PickupSurcharge
+			if len(z.Rates) > 0 {
+				priced = append(priced, z)
+			}
+		}
+		if z.Contains(dropoff) {
+			match.Surcharge += z.DropoffSurcharge
+		}
+	}
+	if areas && !served {
+		return nil, noService("pickup", "pickup is outside of the service area")
+	}
+	if len(priced) > 0 {
+		sort.SliceStable(priced, func(i, j int) bool {
+			return priced[i].Priority > priced[j].Priority
+		})
+		match.Zone = priced[0]
+	}
+	return match, nil
+}
+
+// ZoneCommission returns the commission of the highest priority zone with
+// one containing the point, zero when none does.
+func ZoneCommission(zones []*Zone, p *Point) float64 {
+	var found *Zone
+	for _, z := range zones {
+		if z.Disabled || z.Commission == 0 || !z.Contains(p) {
+			continue
+		}
+		if found == nil || z.Priority > found.Priority {
+			found = z
+		}
+	}
+	if found == nil {
+		return 0
+	}
+	return found.Commission
+}
+
+func noService(param, message string) *Error {
+	err := NewError(ErrNoService, http.StatusBadRequest, message)
+	err.Param = param
+	return err
+}
+
+type ZoneRequest struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Type             ZoneType `json:"type"`
+	Polygon          []*Point `json:"polygon"`
+	Rates            []string `json:"rates,omitempty"`
+	PickupSurcharge  int      `json:"pickup_surcharge,omitempty"`
+	DropoffSurcharge int      `json:"dropoff_surcharge,omitempty"`
+	Priority         int      `json:"priority,omitempty"`
+	Disabled         bool     `json:"disabled,omitempty"`
+	Commission       float64  `json:"commission,omitempty"`
+}
+
+type ZoneFilter struct {
+	Ids   []string
+	Token string
+	Limit int
+	Type  []ZoneType
+}
+
+type ZoneService interface {
+	Create(context.Context, ZoneRequest) (*Zone, error)
+	Update(context.Context, ZoneRequest) (*Zone, error)
+	FindByID(context.Context, string) (*Zone, error)
+	FindAll(context.Context, ZoneFilter) ([]*Zone, string, error)
+}
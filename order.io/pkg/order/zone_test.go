@@ -0,0 +1,92 @@
+package order
+
+import (
+	"errors"
+	"testing"
+)
+
+func square(lat, lng, side float64) []*Point {
+	return []*Point{
+		{Lat: lat, Lng: lng},
+		{Lat: lat + side, Lng: lng},
+		{Lat: lat + side, Lng: lng + side},
+		{Lat: lat, Lng: lng + side},
+	}
+}
+
+func TestZoneContains(t *testing.T) {
+	z := &Zone{Polygon: square(23, -82.5, 0.2)}
+	if !z.Contains(&Point{Lat: 23.1, Lng: -82.4}) {
+		t.Error("point inside the zone not contained")
+	}
+	if z.Contains(&Point{Lat: 23.3, Lng: -82.4}) {
+		t.Error("point outside the zone contained")
+	}
+}
+
+func TestMatchZones(t *testing.T) {
+	city := &Zone{ID: "city", Type: ZoneTypeServiceArea, Polygon: square(23, -82.5, 0.2)}
+	airport := &Zone{ID: "airport", Type: ZoneTypePricing, Polygon: square(23, -82.5, 0.05), Rates: []string{"AIR"}, PickupSurcharge: 500, DropoffSurcharge: 300, Priority: 10}
+	downtown := &Zone{ID: "downtown", Type: ZoneTypePricing, Polygon: square(23, -82.5, 0.1), Rates: []string{"DT"}, Priority: 1}
+	closed := &Zone{ID: "closed", Type: ZoneTypeNoService, Polygon: square(23.15, -82.35, 0.02)}
+	zones := []*Zone{city, airport, downtown, closed}
+
+	inAirport := &Point{Lat: 23.01, Lng: -82.49}
+	inDowntown := &Point{Lat: 23.08, Lng: -82.42}
+	inCity := &Point{Lat: 23.12, Lng: -82.45}
+	inClosed := &Point{Lat: 23.16, Lng: -82.34}
+	outside := &Point{Lat: 24, Lng: -80}
+
+	tests := []struct {
+		name          string
+		points        []*Point
+		wantZone      string
+		wantSurcharge int
+		wantErr       error
+	}{
+		{"airport pickup wins by priority", []*Point{inAirport, inCity}, "airport", 500, nil},
+		{"airport drop off", []*Point{inDowntown, inAirport}, "downtown", 300, nil},
+		{"city without rates", []*Point{inCity, inDowntown}, "", 0, nil},
+		{"outside the service area", []*Point{outside, inCity}, "", 0, ErrNoService},
+		{"stop in a no service zone", []*Point{inCity, inClosed, inDowntown}, "", 0, ErrNoService},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := MatchZones(zones, tt.points)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MatchZones() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var zone string
+			if match.Zone != nil {
+				zone = match.Zone.ID
+			}
+			if zone != tt.wantZone || match.Surcharge != tt.wantSurcharge {
+				t.Errorf("MatchZones() = %s, %d, want %s, %d", zone, match.Surcharge, tt.wantZone, tt.wantSurcharge)
+			}
+		})
+	}
+
+	if _, err := MatchZones([]*Zone{airport}, []*Point{outside}); err != nil {
+		t.Errorf("MatchZones() without service areas error = %v", err)
+	}
+}
+
+func TestZoneCommission(t *testing.T) {
+	city := &Zone{ID: "city", Polygon: square(23, -82.5, 0.2), Commission: 15}
+	airport := &Zone{ID: "airport", Polygon: square(23, -82.5, 0.05), Commission: 25, Priority: 10}
+	downtown := &Zone{ID: "downtown", Polygon: square(23, -82.5, 0.1), Priority: 20}
+	zones := []*Zone{city, airport, downtown}
+
+	if got := ZoneCommission(zones, &Point{Lat: 23.01, Lng: -82.49}); got != 25 {
+		t.Errorf("ZoneCommission() in the airport = %v, want 25", got)
+	}
+	if got := ZoneCommission(zones, &Point{Lat: 23.08, Lng: -82.42}); got != 15 {
+		t.Errorf("ZoneCommission() downtown = %v, want the commission of the city", got)
+	}
+	if got := ZoneCommission(zones, &Point{Lat: 24, Lng: -80}); got != 0 {
+		t.Errorf("ZoneCommission() outside = %v, want 0", got)
+	}
+}
@@ -0,0 +1,21 @@
+package payment
+
+import (
+	"context"
+
+	"order.io/pkg/order"
+)
+
+var _ order.Charger = Cash{}
+
+// Cash charges the rides the rider pays to the driver. Nothing is collected,
+// the fare is recorded as receivable from the driver.
+type Cash struct{}
+
+// Charge implements order.Charger.
+func (Cash) Charge(_ context.Context, req order.ChargeRequest) (*order.Charge, error) {
+	return &order.Charge{
+		ID:     "cash_" + req.IdempotencyKey,
+		Status: order.ChargeStatusReceivable,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"order.io/pkg/order"
+)
+
+var _ order.Charger = &Fake{}
+
+// Fake is a local payment provider. It pays every charge, or fails them with
+// Err when it is set.
+type Fake struct {
+	Provider string
+	Err      error
+}
+
+// Charge implements order.Charger.
+func (f *Fake) Charge(_ context.Context, req order.ChargeRequest) (*order.Charge, error) {
+	if f.Err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Provider, f.Err)
+	}
+	return &order.Charge{
+		ID:     fmt.Sprintf("fake_%s_%s", f.Provider, req.IdempotencyKey),
+		Status: order.ChargeStatusSucceeded,
+	}, nil
+}
@@ -0,0 +1,46 @@
+// Package payment implements the chargers of the charge methods and the
+// settlement of the earnings of the drivers.
+package payment
+
+import (
+	"order.io/pkg/order"
+)
+
+// Config of the payment providers.
+type Config struct {
+	// WalletURL is the base URL of the wallet service and WalletKey the client
+	// key order.io authenticates with. Without them rides cannot be paid with
+	// the balance.
+	WalletURL string
+	WalletKey string
+	// Fake lists the charge methods paid by a Fake provider. It is meant for
+	// development and tests only and must stay empty in production.
+	Fake []order.ChargeMethod
+}
+
+// NewChargers returns the chargers of the configured charge methods: cash,
+// the balance when the wallet service is configured and the fake methods.
+// The methods left out fail with order.ErrInvalidInput.
+func NewChargers(cfg Config) order.Chargers {
+	chargers := order.Chargers{
+		order.ChargeMethodCash: Cash{},
+	}
+	if cfg.WalletURL != "" {
+		chargers[order.ChargeMethodBalance] = NewWallet(cfg.WalletURL, cfg.WalletKey)
+	}
+	for _, method := range cfg.Fake {
+		if _, ok := chargers[method]; !ok {
+			chargers[method] = &Fake{Provider: string(method)}
+		}
+	}
+	return chargers
+}
+
+// NewSettler returns the settler of the earnings of the drivers, nil when
+// the wallet service is not configured.
+func NewSettler(cfg Config) order.Settler {
+	if cfg.WalletURL == "" {
+		return nil
+	}
+	return NewWallet(cfg.WalletURL, cfg.WalletKey)
+}
@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order.io/pkg/order"
+)
+
+func TestNewChargers(t *testing.T) {
+	ctx := context.Background()
+	card := order.ChargeRequest{IdempotencyKey: "order-1", Method: order.ChargeMethodCard, Amount: 1500}
+
+	chargers := NewChargers(Config{})
+	if err := chargers.Authorize(ctx, card); !errors.Is(err, order.ErrInvalidInput) {
+		t.Errorf("Authorize() of a method not configured error = %v, want invalid input", err)
+	}
+	if _, err := chargers.Charge(ctx, card); !errors.Is(err, order.ErrInvalidInput) {
+		t.Errorf("Charge() of a method not configured error = %v, want invalid input", err)
+	}
+	if _, ok := chargers[order.ChargeMethodCash]; !ok {
+		t.Error("NewChargers() did not configure cash")
+	}
+
+	chargers = NewChargers(Config{Fake: []order.ChargeMethod{order.ChargeMethodCard}})
+	if charge, err := chargers.Charge(ctx, card); err != nil || charge.Status != order.ChargeStatusSucceeded {
+		t.Errorf("Charge() of a fake method = %v, %v, want succeeded", charge, err)
+	}
+}
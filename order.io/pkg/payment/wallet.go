@@ -0,0 +1,164 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"order.io/pkg/order"
+)
+
+var (
+	_ order.Authorizer = &Wallet{}
+	_ order.Settler    = &Wallet{}
+)
+
+var errNotFound = errors.New("not found")
+
+// Wallet charges the rides paid with the balance of the rider in the wallet
+// service. The price is held when the ride is confirmed and captured when it
+// finishes. It also settles the earnings of the drivers in their wallets.
+type Wallet struct {
+	BaseURL *url.URL
+	key     string
+	client  *http.Client
+}
+
+func NewWallet(baseURL, key string) *Wallet {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		panic(fmt.Sprintf("invalid wallet url %q: %v", baseURL, err))
+	}
+	return &Wallet{
+		BaseURL: u,
+		key:     key,
+		client:  http.DefaultClient,
+	}
+}
+
+type walletHold struct {
+	ID        string `json:"id,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	Amount    int    `json:"amount"`
+	Currency  string `json:"currency,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// Authorize implements order.Authorizer. The price is held in the wallet of
+// the rider under the idempotency key.
+func (w *Wallet) Authorize(ctx context.Context, req order.ChargeRequest) error {
+	return w.do(ctx, http.MethodPost, "v1/holds", req.IdempotencyKey, walletHold{
+		Owner:     req.Rider,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Reference: req.Order,
+	}, nil)
+}
+
+// Charge implements order.Charger. The final price is captured from the
+// hold, or from the balance when there is no hold. The charge is declined
+// when the wallet refuses it, for instance for insufficient funds, and
+// retried on other failures.
+func (w *Wallet) Charge(ctx context.Context, req order.ChargeRequest) (*order.Charge, error) {
+	var hold walletHold
+	err := w.do(ctx, http.MethodPost, "v1/holds/"+url.PathEscape(req.IdempotencyKey)+"/capture", req.IdempotencyKey, walletHold{
+		Owner:     req.Rider,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Reference: req.Order,
+	}, &hold)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Charge{ID: hold.ID, Status: order.ChargeStatusSucceeded}, nil
+}
+
+// Void implements order.Authorizer. Rides without a hold have nothing to
+// void.
+func (w *Wallet) Void(ctx context.Context, req order.ChargeRequest) error {
+	err := w.do(ctx, http.MethodPost, "v1/holds/"+url.PathEscape(req.IdempotencyKey)+"/release", req.IdempotencyKey, nil, nil)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+	return err
+}
+
+type walletSettlement struct {
+	ID         string `json:"id,omitempty"`
+	Owner      string `json:"owner,omitempty"`
+	Reference  string `json:"reference,omitempty"`
+	Fare       int    `json:"fare"`
+	Commission int    `json:"commission"`
+	Earnings   int    `json:"earnings"`
+	Collected  int    `json:"collected,omitempty"`
+	Captured   int    `json:"captured,omitempty"`
+	Currency   string `json:"currency,omitempty"`
+}
+
+// Settle implements order.Settler. The net earnings are credited to the
+// wallet of the driver, or debited when the driver collected the fare in
+// cash. The wallet applies a settlement once per idempotency key.
+func (w *Wallet) Settle(ctx context.Context, req order.SettlementRequest) (string, error) {
+	var settlement walletSettlement
+	err := w.do(ctx, http.MethodPost, "v1/settlements", req.IdempotencyKey, walletSettlement{
+		Owner:      req.Driver,
+		Reference:  req.Order,
+		Fare:       req.Fare,
+		Commission: req.Commission,
+		Earnings:   req.Earnings,
+		Collected:  req.Collected,
+		Captured:   req.Captured,
+		Currency:   req.Currency,
+	}, &settlement)
+	if errors.Is(err, order.ErrChargeDeclined) || errors.Is(err, errNotFound) {
+		return "", fmt.Errorf("settlement refused: %v: %w", err, order.ErrInvalidInput)
+	}
+	if err != nil {
+		return "", err
+	}
+	return settlement.ID, nil
+}
+
+func (w *Wallet) do(ctx context.Context, method, path, idempotencyKey string, body, v any) error {
+	u, err := w.BaseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+w.key)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach the wallet: %w", err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		if v == nil {
+			return nil
+		}
+		return json.Unmarshal(data, v)
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("wallet: %s: %w", bytes.TrimSpace(data), errNotFound)
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests:
+		return fmt.Errorf("wallet: %s: %w", bytes.TrimSpace(data), order.ErrChargeDeclined)
+	default:
+		return fmt.Errorf("wallet: %s: %s", resp.Status, bytes.TrimSpace(data))
+	}
+}
@@ -0,0 +1,157 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"order.io/pkg/order"
+)
+
+func TestWalletCharge(t *testing.T) {
+	req := order.ChargeRequest{
+		IdempotencyKey: "order-1",
+		Order:          "order-1",
+		Rider:          "rider-1",
+		Method:         order.ChargeMethodBalance,
+		Amount:         1500,
+		Currency:       "CUP",
+	}
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantID       string
+		wantErr      bool
+		wantDeclined bool
+	}{
+		{"paid", http.StatusCreated, `{"id":"charge-1"}`, "charge-1", false, false},
+		{"insufficient funds", http.StatusPaymentRequired, `{"message":"insufficient funds"}`, "", true, true},
+		{"unavailable", http.StatusServiceUnavailable, ``, "", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/holds/order-1/capture" || r.Header.Get("Authorization") != "Bearer sk_test" || r.Header.Get("Idempotency-Key") != "order-1" {
+					t.Errorf("unexpected request %s %v", r.URL.Path, r.Header)
+				}
+				var charge walletHold
+				if err := json.NewDecoder(r.Body).Decode(&charge); err != nil || charge.Owner != "rider-1" || charge.Amount != 1500 {
+					t.Errorf("unexpected charge %+v: %v", charge, err)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			charge, err := NewWallet(server.URL, "sk_test").Charge(context.Background(), req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wallet.Charge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, order.ErrChargeDeclined) != tt.wantDeclined {
+				t.Errorf("Wallet.Charge() error = %v, want declined %v", err, tt.wantDeclined)
+			}
+			if err == nil && (charge.ID != tt.wantID || charge.Status != order.ChargeStatusSucceeded) {
+				t.Errorf("Wallet.Charge() = %+v, want %v", charge, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestWalletAuthorize(t *testing.T) {
+	req := order.ChargeRequest{IdempotencyKey: "order-1", Order: "order-1", Rider: "rider-1", Amount: 1500, Currency: "CUP"}
+	held := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/holds":
+			var hold walletHold
+			json.NewDecoder(r.Body).Decode(&hold)
+			if hold.Amount > 1000 {
+				w.WriteHeader(http.StatusPaymentRequired)
+				return
+			}
+			held[r.Header.Get("Idempotency-Key")] = hold.Amount
+			w.WriteHeader(http.StatusCreated)
+		case "/v1/holds/order-1/release":
+			if _, ok := held["order-1"]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(held, "order-1")
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	w := NewWallet(server.URL, "sk_test")
+	ctx := context.Background()
+
+	if err := w.Authorize(ctx, req); !errors.Is(err, order.ErrChargeDeclined) {
+		t.Fatalf("Wallet.Authorize() over the balance error = %v, want declined", err)
+	}
+	req.Amount = 800
+	if err := w.Authorize(ctx, req); err != nil || held["order-1"] != 800 {
+		t.Fatalf("Wallet.Authorize() = %v, held %v", err, held)
+	}
+	if err := w.Void(ctx, req); err != nil || len(held) != 0 {
+		t.Fatalf("Wallet.Void() = %v, held %v", err, held)
+	}
+	if err := w.Void(ctx, req); err != nil {
+		t.Errorf("Wallet.Void() without hold error = %v", err)
+	}
+}
+
+func TestWalletSettle(t *testing.T) {
+	req := order.SettlementRequest{
+		IdempotencyKey: "order-1",
+		Order:          "order-1",
+		Driver:         "driver-1",
+		Fare:           1000,
+		Commission:     200,
+		Earnings:       800,
+		Collected:      1000,
+		Currency:       "CUP",
+	}
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantID      string
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{"settled", http.StatusCreated, `{"id":"transfer-1"}`, "transfer-1", false, false},
+		{"refused", http.StatusBadRequest, `{"message":"invalid currency"}`, "", true, true},
+		{"unavailable", http.StatusServiceUnavailable, ``, "", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/settlements" || r.Header.Get("Idempotency-Key") != "order-1" {
+					t.Errorf("unexpected request %s %v", r.URL.Path, r.Header)
+				}
+				var settlement walletSettlement
+				if err := json.NewDecoder(r.Body).Decode(&settlement); err != nil || settlement.Owner != "driver-1" || settlement.Earnings != 800 || settlement.Collected != 1000 {
+					t.Errorf("unexpected settlement %+v: %v", settlement, err)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			id, err := NewWallet(server.URL, "sk_test").Settle(context.Background(), req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wallet.Settle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, order.ErrInvalidInput) != tt.wantInvalid {
+				t.Errorf("Wallet.Settle() error = %v, want invalid %v", err, tt.wantInvalid)
+			}
+			if id != tt.wantID {
+				t.Errorf("Wallet.Settle() = %v, want %v", id, tt.wantID)
+			}
+		})
+	}
+}
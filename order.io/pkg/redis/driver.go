@@ -2,7 +2,12 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
@@ -11,6 +16,17 @@ import (
 
 var key = "drivers"
 
+const (
+	// seenKey scores each driver in the index with its last location update.
+	seenKey = "drivers:seen"
+	// busyKey holds the drivers on an active trip.
+	busyKey = "drivers:busy"
+	// offlineKey holds the drivers that went offline.
+	offlineKey = "drivers:offline"
+)
+
+var _ order.DriverService = &RealTimeService{}
+
 type RealTimeService struct {
 	redis *Redis
 }
@@ -19,7 +35,10 @@ func NewRealTimeService(redis *Redis) *RealTimeService {
 	return &RealTimeService{redis: redis}
 }
 
-func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location order.GeoLocation) ([]*order.Location, error) {
+// FindNearByDrivers returns the drivers around the location not on a trip
+// whose active vehicle serves the category. An empty category matches any
+// driver, and so do the drivers without a tagged vehicle.
+func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location order.GeoLocation, category order.VehicleCategory) ([]*order.Location, error) {
 	res, _ := s.redis.client.GeoRadius(ctx, key, location.Long, location.Lat, &redis.GeoRadiusQuery{
 		Radius:      500, // TODO: change this to 5km
 		Unit:        "km",
@@ -29,8 +48,24 @@ func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location order.
 		Count:       20,
 		Sort:        "ASC",
 	}).Result()
+	busy, err := s.busyDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var locations []*order.Location
 	for _, l := range res {
+		if busy[l.Name] {
+			continue
+		}
+		if category != "" {
+			vehicle, err := s.Vehicle(ctx, l.Name)
+			if err != nil && !errors.Is(err, order.ErrNotFound) {
+				continue
+			}
+			if vehicle != nil && !vehicle.Serves(category) {
+				continue
+			}
+		}
 		var riderLocation = order.Location{
 			User: l.Name,
 			Geolocation: order.GeoLocation{
@@ -45,15 +80,309 @@ func (s *RealTimeService) FindNearByDrivers(ctx context.Context, location order.
 	return locations, nil
 }
 
+// UpdateLocation stores the driver location and heartbeat. Locations of
+// offline drivers are ignored.
 func (s *RealTimeService) UpdateLocation(ctx context.Context, user string, location order.GeoLocation) error {
+	offline, err := s.redis.client.SIsMember(ctx, offlineKey, user).Result()
+	if err != nil {
+		return fmt.Errorf("unable to get driver availability: %v: %w", err, order.ErrInternal)
+	}
+	if offline {
+		return nil
+	}
 	var geoLocations []*redis.GeoLocation
 	geoLocations = append(geoLocations, &redis.GeoLocation{
 		Name:      user,
 		Longitude: location.Long,
 		Latitude:  location.Lat,
 	})
-	if err := s.redis.client.GeoAdd(ctx, key, geoLocations...).Err(); err != nil {
+	pipe := s.redis.client.TxPipeline()
+	pipe.GeoAdd(ctx, key, geoLocations...)
+	pipe.ZAdd(ctx, seenKey, redis.Z{Score: float64(time.Now().Unix()), Member: user})
+	if _, err := pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("unable to update driver locations: %v: %w", err, order.ErrInternal)
 	}
 	return nil
 }
+
+// SetAvailable removes the drivers going offline from the index. Available
+// drivers are added back with their next location.
+func (s *RealTimeService) SetAvailable(ctx context.Context, driver string, available bool) error {
+	pipe := s.redis.client.TxPipeline()
+	if available {
+		pipe.SRem(ctx, offlineKey, driver)
+	} else {
+		pipe.SAdd(ctx, offlineKey, driver)
+		pipe.ZRem(ctx, key, driver)
+		pipe.ZRem(ctx, seenKey, driver)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("unable to set driver availability: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// SetOnTrip flags the driver as busy with a trip, so it is not matched with
+// other orders while its location keeps being tracked.
+func (s *RealTimeService) SetOnTrip(ctx context.Context, driver string, onTrip bool) error {
+	var err error
+	if onTrip {
+		err = s.redis.client.SAdd(ctx, busyKey, driver).Err()
+	} else {
+		err = s.redis.client.SRem(ctx, busyKey, driver).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to set driver trip: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+func (s *RealTimeService) busyDrivers(ctx context.Context) (map[string]bool, error) {
+	members, err := s.redis.client.SMembers(ctx, busyKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get busy drivers: %v: %w", err, order.ErrInternal)
+	}
+	busy := make(map[string]bool, len(members))
+	for _, m := range members {
+		busy[m] = true
+	}
+	return busy, nil
+}
+
+// Sweep removes from the index the drivers without a location update in the
+// last maxAge, and from the busy drivers, and returns them.
+func (s *RealTimeService) Sweep(ctx context.Context, now time.Time, maxAge time.Duration) ([]string, error) {
+	stale, err := s.redis.client.ZRangeByScore(ctx, seenKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.Add(-maxAge).Unix(), 10),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find stale drivers: %v: %w", err, order.ErrInternal)
+	}
+	if len(stale) == 0 {
+		return nil, nil
+	}
+	members := make([]interface{}, len(stale))
+	for i, d := range stale {
+		members[i] = d
+	}
+	pipe := s.redis.client.TxPipeline()
+	pipe.ZRem(ctx, key, members...)
+	pipe.ZRem(ctx, seenKey, members...)
+	pipe.SRem(ctx, busyKey, members...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("unable to remove stale drivers: %v: %w", err, order.ErrInternal)
+	}
+	return stale, nil
+}
+
+// RunSweeper sweeps the stale drivers every interval until the context is
+// done.
+func (s *RealTimeService) RunSweeper(ctx context.Context, interval, maxAge time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			stale, err := s.Sweep(ctx, now, maxAge)
+			if err != nil {
+				slog.Info("unable to sweep stale drivers", "error", err)
+				continue
+			}
+			if len(stale) > 0 {
+				slog.Debug("removed stale drivers", "drivers", stale)
+			}
+		}
+	}
+}
+
+// OnlineDrivers implements order.DriverService.
+func (s *RealTimeService) OnlineDrivers(ctx context.Context) ([]*order.OnlineDriver, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	seen, err := s.redis.client.ZRevRangeWithScores(ctx, seenKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get online drivers: %v: %w", err, order.ErrInternal)
+	}
+	busy, err := s.busyDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drivers := make([]*order.OnlineDriver, 0, len(seen))
+	for _, z := range seen {
+		id, _ := z.Member.(string)
+		location, err := s.Location(ctx, id)
+		if err != nil {
+			continue
+		}
+		d := &order.OnlineDriver{
+			Driver:   id,
+			Location: location,
+			LastSeen: int64(z.Score),
+			OnTrip:   busy[id],
+		}
+		d.Vehicle, _ = s.Vehicle(ctx, id)
+		drivers = append(drivers, d)
+	}
+	return drivers, nil
+}
+
+// AvailableDrivers returns the position of the drivers in the index not on
+// a trip.
+func (s *RealTimeService) AvailableDrivers(ctx context.Context) ([]*order.Point, error) {
+	members, err := s.redis.client.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get drivers: %v: %w", err, order.ErrInternal)
+	}
+	busy, err := s.busyDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var available []string
+	for _, m := range members {
+		if !busy[m] {
+			available = append(available, m)
+		}
+	}
+	if len(available) == 0 {
+		return nil, nil
+	}
+	res, err := s.redis.client.GeoPos(ctx, key, available...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get driver locations: %v: %w", err, order.ErrInternal)
+	}
+	points := make([]*order.Point, 0, len(res))
+	for _, pos := range res {
+		if pos != nil {
+			points = append(points, &order.Point{Lat: pos.Latitude, Lng: pos.Longitude})
+		}
+	}
+	return points, nil
+}
+
+// Location returns the last known position of the user in the drivers index.
+func (s *RealTimeService) Location(ctx context.Context, user string) (*order.Point, error) {
+	res, err := s.redis.client.GeoPos(ctx, key, user).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get driver location: %v: %w", err, order.ErrInternal)
+	}
+	if len(res) == 0 || res[0] == nil {
+		return nil, fmt.Errorf("driver location: %w", order.ErrNotFound)
+	}
+	return &order.Point{Lat: res[0].Latitude, Lng: res[0].Longitude}, nil
+}
+
+// statsKey is the hash holding the active vehicle and the dispatch stats of
+// a driver.
+func statsKey(driver string) string {
+	return "driver:" + driver
+}
+
+// Candidates returns the drivers within radius meters of the pickup point of
+// the order that are not on a trip and whose active vehicle is eligible for
+// it, nearest first, with the stats used to rank them for an offer. Drivers
+// that did not tag their vehicle yet are eligible for any order.
+func (s *RealTimeService) Candidates(ctx context.Context, o *order.Order, radius float64) ([]*order.Candidate, error) {
+	pickup := o.Item.Points[0]
+	res, err := s.redis.client.GeoRadius(ctx, key, pickup.Lng, pickup.Lat, &redis.GeoRadiusQuery{
+		Radius:   radius,
+		Unit:     "m",
+		WithDist: true,
+		Count:    50,
+		Sort:     "ASC",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find nearby drivers: %v: %w", err, order.ErrInternal)
+	}
+	busy, err := s.busyDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*order.Candidate, 0, len(res))
+	for _, l := range res {
+		if busy[l.Name] {
+			continue
+		}
+		stats, err := s.redis.client.HGetAll(ctx, statsKey(l.Name)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get driver stats: %v: %w", err, order.ErrInternal)
+		}
+		vehicle := assembleVehicle(stats)
+		if vehicle != nil && !vehicle.Eligible(o) {
+			continue
+		}
+		c := &order.Candidate{
+			Driver:         l.Name,
+			Distance:       l.Dist,
+			AcceptanceRate: 1,
+		}
+		if vehicle != nil {
+			c.Category = vehicle.Category
+		}
+		offers, _ := strconv.ParseFloat(stats["offers"], 64)
+		accepts, _ := strconv.ParseFloat(stats["accepts"], 64)
+		if offers > 0 {
+			c.AcceptanceRate = min(accepts/offers, 1)
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
+
+// SetVehicle tags the driver in the index with its active vehicle.
+func (s *RealTimeService) SetVehicle(ctx context.Context, driver string, vehicle *order.DriverVehicle) error {
+	err := s.redis.client.HSet(ctx, statsKey(driver),
+		"category", string(vehicle.Category),
+		"seats", vehicle.Seats,
+		"facilities", strings.Join(vehicle.Facilities, ","),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("unable to set driver vehicle: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// Vehicle returns the active vehicle the driver is tagged with.
+func (s *RealTimeService) Vehicle(ctx context.Context, driver string) (*order.DriverVehicle, error) {
+	stats, err := s.redis.client.HGetAll(ctx, statsKey(driver)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get driver vehicle: %v: %w", err, order.ErrInternal)
+	}
+	vehicle := assembleVehicle(stats)
+	if vehicle == nil {
+		return nil, fmt.Errorf("driver vehicle: %w", order.ErrNotFound)
+	}
+	return vehicle, nil
+}
+
+func assembleVehicle(stats map[string]string) *order.DriverVehicle {
+	if stats["category"] == "" {
+		return nil
+	}
+	vehicle := &order.DriverVehicle{Category: order.VehicleCategory(stats["category"])}
+	vehicle.Seats, _ = strconv.Atoi(stats["seats"])
+	if facilities := stats["facilities"]; facilities != "" {
+		vehicle.Facilities = strings.Split(facilities, ",")
+	}
+	return vehicle
+}
+
+// RecordOffer counts an offer made to the driver.
+func (s *RealTimeService) RecordOffer(ctx context.Context, driver string) error {
+	if err := s.redis.client.HIncrBy(ctx, statsKey(driver), "offers", 1).Err(); err != nil {
+		return fmt.Errorf("unable to record driver offer: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// RecordAccept counts an offer accepted by the driver.
+func (s *RealTimeService) RecordAccept(ctx context.Context, driver string) error {
+	if err := s.redis.client.HIncrBy(ctx, statsKey(driver), "accepts", 1).Err(); err != nil {
+		return fmt.Errorf("unable to record driver accept: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
@@ -53,6 +53,17 @@ func (db *Redis) Orders(ctx context.Context) ([]string, error) {
 	return orders, nil
 }
 
-func (db *Redis) Subscripe(ctx context.Context, channel string) *redis.PubSub {
-	return db.client.Subscribe(ctx, channel)
+func (db *Redis) Subscripe(ctx context.Context, channels ...string) *redis.PubSub {
+	return db.client.Subscribe(ctx, channels...)
+}
+
+// SubscribeActive subscribes to the channels and waits for the subscription,
+// so no message published after it returns is missed.
+func (db *Redis) SubscribeActive(ctx context.Context, channels ...string) (*redis.PubSub, error) {
+	pubsub := db.client.Subscribe(ctx, channels...)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe: %v: %w", err, order.ErrInternal)
+	}
+	return pubsub, nil
 }
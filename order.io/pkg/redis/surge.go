@@ -0,0 +1,188 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/redis/go-redis/v9"
+
+	"order.io/pkg/order"
+)
+
+const (
+	// surgeKey holds the computed surge of each cell.
+	surgeKey = "surge"
+	// surgeOverrideKey holds the surge of the cells overridden by an admin.
+	surgeOverrideKey = "surge:overrides"
+)
+
+var _ order.SurgeService = &SurgeService{}
+
+type SurgeService struct {
+	redis  *Redis
+	policy order.SurgePolicy
+}
+
+func NewSurgeService(redis *Redis, policy order.SurgePolicy) *SurgeService {
+	return &SurgeService{redis: redis, policy: policy}
+}
+
+// Surge implements order.SurgeService. An override replaces the computed
+// multiplier until it expires; cells without surge have a multiplier of 1.
+func (s *SurgeService) Surge(ctx context.Context, p order.Point) (*order.Surge, error) {
+	cell := s.policy.Cell(&p)
+	now := time.Now()
+	override, err := s.get(ctx, surgeOverrideKey, cell)
+	if err != nil {
+		return nil, err
+	}
+	if override != nil && override.ExpiresAt > now.Unix() {
+		return override, nil
+	}
+	surge, err := s.get(ctx, surgeKey, cell)
+	if err != nil {
+		return nil, err
+	}
+	if surge == nil {
+		return &order.Surge{Cell: cell, Multiplier: 1, UpdatedAt: now.Unix()}, nil
+	}
+	return surge, nil
+}
+
+// Surges implements order.SurgeService.
+func (s *SurgeService) Surges(ctx context.Context) ([]*order.Surge, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	computed, err := s.Computed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.all(ctx, surgeOverrideKey)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	for cell, o := range overrides {
+		if o.ExpiresAt > now {
+			computed[cell] = o
+		}
+	}
+	surges := make([]*order.Surge, 0, len(computed))
+	for _, surge := range computed {
+		surges = append(surges, surge)
+	}
+	return surges, nil
+}
+
+// SetSurgeOverride implements order.SurgeService.
+func (s *SurgeService) SetSurgeOverride(ctx context.Context, p order.Point, multiplier float64, expiresAt int64) (*order.Surge, error) {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return nil, order.ErrAccessDenied
+	}
+	if !p.Valid() {
+		return nil, order.NewInvalidParameter("point", p)
+	}
+	if multiplier < 1 {
+		return nil, order.NewInvalidParameter("multiplier", multiplier)
+	}
+	now := time.Now().Unix()
+	if expiresAt <= now {
+		return nil, order.NewInvalidParameter("expires_at", expiresAt)
+	}
+	surge := &order.Surge{
+		Cell:       s.policy.Cell(&p),
+		Multiplier: multiplier,
+		Override:   true,
+		ExpiresAt:  expiresAt,
+		UpdatedAt:  now,
+	}
+	if err := s.set(ctx, surgeOverrideKey, surge); err != nil {
+		return nil, err
+	}
+	return surge, nil
+}
+
+// ClearSurgeOverride implements order.SurgeService.
+func (s *SurgeService) ClearSurgeOverride(ctx context.Context, p order.Point) error {
+	usr := order.UserFromContext(ctx)
+	if usr == nil || usr.Role != order.RoleAdmin {
+		return order.ErrAccessDenied
+	}
+	if err := s.redis.client.HDel(ctx, surgeOverrideKey, s.policy.Cell(&p)).Err(); err != nil {
+		return fmt.Errorf("unable to clear surge override: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+// Computed returns the computed surge of the cells by cell.
+func (s *SurgeService) Computed(ctx context.Context) (map[string]*order.Surge, error) {
+	return s.all(ctx, surgeKey)
+}
+
+// Store replaces the computed surge of the cells and removes the cells back
+// to normal.
+func (s *SurgeService) Store(ctx context.Context, surges []*order.Surge, removed []string) error {
+	pipe := s.redis.client.TxPipeline()
+	for _, surge := range surges {
+		data, err := json.Marshal(surge)
+		if err != nil {
+			return fmt.Errorf("unable to marshal surge: %v: %w", err, order.ErrInternal)
+		}
+		pipe.HSet(ctx, surgeKey, surge.Cell, data)
+	}
+	if len(removed) > 0 {
+		pipe.HDel(ctx, surgeKey, removed...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("unable to store surge: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+func (s *SurgeService) get(ctx context.Context, key, cell string) (*order.Surge, error) {
+	data, err := s.redis.client.HGet(ctx, key, cell).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get surge: %v: %w", err, order.ErrInternal)
+	}
+	var surge order.Surge
+	if err := json.Unmarshal(data, &surge); err != nil {
+		return nil, fmt.Errorf("unable to decode surge: %v: %w", err, order.ErrInternal)
+	}
+	return &surge, nil
+}
+
+func (s *SurgeService) set(ctx context.Context, key string, surge *order.Surge) error {
+	data, err := json.Marshal(surge)
+	if err != nil {
+		return fmt.Errorf("unable to marshal surge: %v: %w", err, order.ErrInternal)
+	}
+	if err := s.redis.client.HSet(ctx, key, surge.Cell, data).Err(); err != nil {
+		return fmt.Errorf("unable to store surge: %v: %w", err, order.ErrInternal)
+	}
+	return nil
+}
+
+func (s *SurgeService) all(ctx context.Context, key string) (map[string]*order.Surge, error) {
+	values, err := s.redis.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get surge: %v: %w", err, order.ErrInternal)
+	}
+	surges := make(map[string]*order.Surge, len(values))
+	for cell, data := range values {
+		var surge order.Surge
+		if err := json.Unmarshal([]byte(data), &surge); err != nil {
+			continue
+		}
+		surges[cell] = &surge
+	}
+	return surges, nil
+}
@@ -39,7 +39,7 @@ func NewRate(db *mongo.DB) *Rate {
 				PricePerPassenger: 10000,
 				PricePerBaggage:   5000,
 				StartTime:         "08:30",
-				EndTime:           "15:59",
+				EndTime:           "16:00",
 				MaxKm:             12000,
 			},
 			{
@@ -49,7 +49,7 @@ func NewRate(db *mongo.DB) *Rate {
 				PricePerPassenger: 10000,
 				PricePerBaggage:   5000,
 				StartTime:         "16:00",
-				EndTime:           "19:59",
+				EndTime:           "20:00",
 				MaxKm:             12000,
 			},
 			{
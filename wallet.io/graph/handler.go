@@ -9,9 +9,11 @@ import (
 
 func NewHandler(
 	wallet wallet.WalletService,
+	ledger wallet.LedgerService,
 ) *handler.Server {
 	resolver := &Resolver{
 		wallet: wallet,
+		ledger: ledger,
 	}
 	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
 	srv.AddTransport(&transport.Websocket{})
@@ -7,14 +7,64 @@ import (
 	"wallet.io/pkg/wallet"
 )
 
-func assembleModelTransfer(transfer *wallet.TransferEvent) *model.Transfer {
-	t := time.Unix(int64(transfer.CreatedAt), 0)
-	return &model.Transfer{
+func assembleModelTransfer(transfer *wallet.Transfer) *model.Transfer {
+	t := &model.Transfer{
 		ID:        transfer.ID,
 		Amount:    int(transfer.Amount),
 		Currency:  transfer.Currency,
 		From:      transfer.From,
 		To:        transfer.To,
-		CreatedAt: t.Format("2006-01-02 15:04:05"),
+		Status:    transfer.Status.String(),
+		CreatedAt: time.Unix(int64(transfer.CreatedAt), 0).Format("2006-01-02 15:04:05"),
+		UpdatedAt: time.Unix(int64(transfer.UpdatedAt), 0).Format("2006-01-02 15:04:05"),
+		ExpiresAt: time.Unix(int64(transfer.ExpiresAt), 0).Format("2006-01-02 15:04:05"),
 	}
+	if transfer.Reason != "" {
+		t.Reason = &transfer.Reason
+	}
+	return t
+}
+
+func assembleModelTransaction(transfer *wallet.TransferEvent) *model.Transaction {
+	t := &model.Transaction{
+		ID:        transfer.ID,
+		Type:      string(transfer.Type),
+		Status:    transfer.Status.String(),
+		Amount:    int(transfer.Amount),
+		Currency:  transfer.Currency,
+		CreatedAt: time.Unix(int64(transfer.CreatedAt), 0).Format("2006-01-02 15:04:05"),
+	}
+	if transfer.From != "" {
+		t.From = &transfer.From
+	}
+	if transfer.To != "" {
+		t.To = &transfer.To
+	}
+	if e := transfer.Earnings; e != nil {
+		t.Earnings = &model.Earnings{
+			Fare:       int(e.Fare),
+			Commission: int(e.Commission),
+			Earnings:   int(e.Earnings),
+			Collected:  int(e.Collected),
+		}
+		if e.Reference != "" {
+			t.Earnings.Reference = &e.Reference
+		}
+	}
+	return t
+}
+
+func assembleModelReconciliation(rec *wallet.Reconciliation) *model.Reconciliation {
+	r := &model.Reconciliation{
+		Account: rec.Account,
+		Drifts:  make([]*model.Drift, 0, len(rec.Drifts)),
+	}
+	for _, d := range rec.Drifts {
+		r.Drifts = append(r.Drifts, &model.Drift{
+			Currency: d.Currency,
+			Cached:   int(d.Cached),
+			Ledger:   int(d.Ledger),
+		})
+	}
+	return r
 }
@@ -10,6 +10,26 @@ type Balance struct {
 	Currency string `json:"currency"`
 }
 
+// Cached balance of a wallet differing from the ledger
+type Drift struct {
+	Currency string `json:"currency"`
+	Cached   int    `json:"cached"`
+	Ledger   int    `json:"ledger"`
+}
+
+// Split of the fare of a trip between the platform and the driver
+type Earnings struct {
+	// Trip of the earnings
+	Reference *string `json:"reference,omitempty"`
+	Fare      int     `json:"fare"`
+	// Commission kept by the platform
+	Commission int `json:"commission"`
+	// Fare minus the commission
+	Earnings int `json:"earnings"`
+	// Fare collected in cash by the driver, owed to the platform
+	Collected int `json:"collected"`
+}
+
 type Error struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -21,21 +41,54 @@ type Mutation struct {
 type Query struct {
 }
 
+type Reconciliation struct {
+	Account string `json:"account"`
+	// Drifted balances, set to the ledger ones
+	Drifts []*Drift `json:"drifts"`
+}
+
 type Response struct {
 	Success bool     `json:"success"`
 	Message *string  `json:"message,omitempty"`
 	Errors  []*Error `json:"errors,omitempty"`
 }
 
-type Transfer struct {
-	ID        string `json:"id"`
-	From      string `json:"from"`
-	To        string `json:"to"`
+type Transaction struct {
+	ID string `json:"id"`
+	// deposit, withdraw, transfer, hold, release, payment or earnings
+	Type   string  `json:"type"`
+	Status string  `json:"status"`
+	From   *string `json:"from,omitempty"`
+	To     *string `json:"to,omitempty"`
+	// Change of the balance
 	Amount    int    `json:"amount"`
 	Currency  string `json:"currency"`
-	Status    string `json:"status"`
 	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
+	// Split of the fare of the earnings transactions
+	Earnings *Earnings `json:"earnings,omitempty"`
+}
+
+type TransactionsResponse struct {
+	// List of the transactions
+	Items []*Transaction `json:"items"`
+	// Next page token
+	Token string `json:"token"`
+}
+
+type Transfer struct {
+	ID       string `json:"id"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+	// PENDING, CONFIRMED, FAILED or CANCELLED
+	Status string `json:"status"`
+	// Why the transfer failed
+	Reason    *string `json:"reason,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+	// When the pending transfer expires
+	ExpiresAt string `json:"expiresAt"`
 }
 
 type Wallet struct {
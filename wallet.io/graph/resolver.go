@@ -9,4 +9,5 @@ import "wallet.io/pkg/wallet"
 
 type Resolver struct {
 	wallet wallet.WalletService
+	ledger wallet.LedgerService
 }
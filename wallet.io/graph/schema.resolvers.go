@@ -2,12 +2,13 @@ package graph
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.43
+// Code generated by github.com/99designs/gqlgen version v0.17.45
 
 import (
 	"context"
 
 	"wallet.io/graph/model"
+	"wallet.io/pkg/wallet"
 )
 
 // SetPin is the resolver for the setPin field.
@@ -15,7 +16,11 @@ func (r *mutationResolver) SetPin(ctx context.Context, pin string, old *string)
 	rsp := &model.Response{
 		Success: true,
 	}
-	if err := r.wallet.SetPin(ctx, *old, pin); err != nil {
+	var current string
+	if old != nil {
+		current = *old
+	}
+	if err := r.wallet.SetPin(ctx, current, pin); err != nil {
 		rsp.Success = false
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Message: err.Error(),
@@ -52,7 +57,7 @@ func (r *mutationResolver) ConfirmTransfer(ctx context.Context, id string, pin s
 	rsp := &model.Response{
 		Success: true,
 	}
-	if err := r.wallet.ConfirmTransfer(ctx, id, pin); err != nil {
+	if _, err := r.wallet.ConfirmTransfer(ctx, id, pin); err != nil {
 		rsp.Success = false
 		rsp.Errors = append(rsp.Errors, &model.Error{
 			Message: err.Error(),
@@ -61,6 +66,24 @@ func (r *mutationResolver) ConfirmTransfer(ctx context.Context, id string, pin s
 	return rsp, nil
 }
 
+// CancelTransfer is the resolver for the cancelTransfer field.
+func (r *mutationResolver) CancelTransfer(ctx context.Context, id string) (*model.Transfer, error) {
+	transfer, err := r.wallet.CancelTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelTransfer(transfer), nil
+}
+
+// Reconcile is the resolver for the reconcile field.
+func (r *mutationResolver) Reconcile(ctx context.Context, owner string) (*model.Reconciliation, error) {
+	rec, err := r.ledger.Reconcile(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return assembleModelReconciliation(rec), nil
+}
+
 // Balance is the resolver for the balance field.
 func (r *queryResolver) Balance(ctx context.Context, currency string) (int, error) {
 	balance, err := r.wallet.Balance(ctx)
@@ -70,6 +93,38 @@ func (r *queryResolver) Balance(ctx context.Context, currency string) (int, erro
 	return int(balance.Amount[currency]), nil
 }
 
+// Transactions is the resolver for the transactions field.
+func (r *queryResolver) Transactions(ctx context.Context, limit *int, token *string) (*model.TransactionsResponse, error) {
+	filter := wallet.TransactionFilter{}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if token != nil {
+		filter.Token = *token
+	}
+	transactions, next, err := r.wallet.Transactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	res := &model.TransactionsResponse{
+		Items: make([]*model.Transaction, 0, len(transactions)),
+		Token: next,
+	}
+	for i := range transactions {
+		res.Items = append(res.Items, assembleModelTransaction(&transactions[i]))
+	}
+	return res, nil
+}
+
+// AccountBalance is the resolver for the accountBalance field.
+func (r *queryResolver) AccountBalance(ctx context.Context, account string, currency string) (int, error) {
+	balance, err := r.ledger.Balance(ctx, account, currency)
+	if err != nil {
+		return 0, err
+	}
+	return int(balance), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
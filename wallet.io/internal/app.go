@@ -33,6 +33,9 @@ func New(cfg Config) *App {
 		done:    make(chan struct{}),
 	}
 
+	if err := mongo.OpenLedger(context.Background(), app.mongo); err != nil {
+		panic(fmt.Sprintf("unable to open the ledger: %v", err))
+	}
 	app.loader()
 
 	return app
@@ -45,6 +48,8 @@ func (a *App) Start(ctx context.Context) error {
 		Handler: a.router,
 	}
 
+	go mongo.NewTransferExpirer(a.mongo).Run(ctx)
+
 	fmt.Println("Starting server on", addr)
 
 	ch := make(chan error, 1)
@@ -107,13 +112,18 @@ func (a *App) loader() {
 	router.Use(httprate.LimitByIP(100, 1*time.Minute))
 	router.Use(jwtauth.Verifier(a.jwtAuth))
 	router.Use(TokenAuthMiddleware(a.jwtAuth))
+	router.Use(ClientAuthenticate(a.config.ClientKeys))
 	router.Use(middleware.Heartbeat("/ping"))
 
 	router.Mount("/debug", middleware.Profiler())
 
+	router.Mount("/v1/holds", holdRoutes(mongo.NewHoldService(a.mongo)))
+	router.Mount("/v1/settlements", settlementRoutes(mongo.NewSettlementService(a.mongo)))
+
 	router.Group(func(r chi.Router) {
 		grapgqlSrv := graph.NewHandler(
 			mongo.NewWalletService(a.mongo),
+			mongo.NewLedgerService(a.mongo),
 		)
 
 		r.Handle("/", playground.Handler("Wallet playground", "/query"))
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type DB struct {
@@ -50,6 +51,9 @@ type Config struct {
 	MongoDatabase string
 
 	JWTPrivateKey string
+	// ClientKeys are the secret keys of the services calling the wallet,
+	// mapped to the name of the service.
+	ClientKeys map[string]string
 }
 
 func LoadConfig() Config {
@@ -97,5 +101,17 @@ func LoadConfig() Config {
 		cfg.JWTPrivateKey = key
 	}
 
+	// CLIENT_KEYS is a comma separated list of name:sk_key
+	if keys := os.Getenv("CLIENT_KEYS"); len(keys) > 0 {
+		cfg.ClientKeys = make(map[string]string)
+		for _, pair := range strings.Split(keys, ",") {
+			name, key, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || name == "" || !strings.HasPrefix(key, "sk_") {
+				panic(fmt.Sprintf("invalid client key of %q", name))
+			}
+			cfg.ClientKeys[key] = name
+		}
+	}
+
 	return cfg
 }
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wallet.io/pkg/wallet"
+)
+
+// holdRoutes is the API of the holds for the other services.
+func holdRoutes(service wallet.HoldService) http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+		var req wallet.HoldRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, wallet.NewInvalidParameter("body", err))
+			return
+		}
+		req.Key = r.Header.Get("Idempotency-Key")
+		hold, err := service.Hold(r.Context(), req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, hold)
+	})
+	r.Post("/{key}/capture", func(w http.ResponseWriter, r *http.Request) {
+		var req wallet.CaptureRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, wallet.NewInvalidParameter("body", err))
+			return
+		}
+		hold, err := service.Capture(r.Context(), chi.URLParam(r, "key"), req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, hold)
+	})
+	r.Post("/{key}/release", func(w http.ResponseWriter, r *http.Request) {
+		hold, err := service.Release(r.Context(), chi.URLParam(r, "key"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, hold)
+	})
+	return r
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, wallet.ErrAccessDenied):
+		status = http.StatusUnauthorized
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		status = http.StatusPaymentRequired
+	case errors.Is(err, wallet.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, wallet.ErrConflict):
+		status = http.StatusConflict
+	case errors.Is(err, wallet.ErrInvalid), errors.Is(err, wallet.ErrInvalidInput):
+		status = http.StatusBadRequest
+	}
+	message := err.Error()
+	if status == http.StatusInternalServerError {
+		message = "internal error"
+	}
+	writeJSON(w, status, wallet.Error{Message: message, StatusCode: status})
+}
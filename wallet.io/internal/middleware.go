@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -153,3 +154,25 @@ func TokenAuthMiddleware(tokenAuth *jwtauth.JWTAuth) func(next http.Handler) htt
 		})
 	}
 }
+
+// ClientAuthenticate identifies the services calling with one of the client
+// keys.
+func ClientAuthenticate(keys map[string]string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := requestToken(r)
+			if !strings.HasPrefix(token, "sk_") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			for key, name := range keys {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+					ctx := wallet.NewContextWithClient(r.Context(), &wallet.Client{Name: name})
+					next.ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+	}
+}
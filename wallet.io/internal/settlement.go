@@ -0,0 +1,31 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"wallet.io/pkg/wallet"
+)
+
+// settlementRoutes is the API of the earnings settlements for the other
+// services.
+func settlementRoutes(service wallet.SettlementService) http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+		var req wallet.SettlementRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, wallet.NewInvalidParameter("body", err))
+			return
+		}
+		req.Key = r.Header.Get("Idempotency-Key")
+		transfer, err := service.Settle(r.Context(), req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, transfer)
+	})
+	return r
+}
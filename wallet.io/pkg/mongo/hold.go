@@ -0,0 +1,256 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"wallet.io/pkg/derrors"
+	"wallet.io/pkg/wallet"
+)
+
+const HoldCollection Collections = "holds"
+
+var _ wallet.HoldService = (*HoldService)(nil)
+
+type HoldService struct {
+	db *DB
+}
+
+func NewHoldService(db *DB) *HoldService {
+	return &HoldService{db: db}
+}
+
+// Hold implements wallet.HoldService. A hold placed again with the same key
+// returns the first one, unless it was released.
+func (s *HoldService) Hold(ctx context.Context, req wallet.HoldRequest) (_ *wallet.Hold, err error) {
+	defer derrors.Wrap(&err, "mongo.HoldService.Hold")
+	client := wallet.ClientFromContext(ctx)
+	if client == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var hold *wallet.Hold
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		hold, err = findHold(ctx, s.db, wallet.HoldID(client.Name, req.Key))
+		if err == nil {
+			if hold.Owner != req.Owner {
+				return fmt.Errorf("hold %s belongs to another wallet: %w", req.Key, wallet.ErrConflict)
+			}
+			if hold.Status != wallet.HoldStatusReleased {
+				return nil
+			}
+			// a released hold is placed again
+			hold.Amount = req.Amount
+			hold.Currency = req.Currency
+			hold.Status = wallet.HoldStatusHeld
+			if err := updateHold(ctx, s.db, hold, wallet.HoldStatusReleased); err != nil {
+				return err
+			}
+			return postHold(ctx, s.db, hold)
+		}
+		if !errors.Is(err, wallet.ErrNotFound) {
+			return err
+		}
+		now := uint(wallet.Now().UTC().Unix())
+		hold = &wallet.Hold{
+			ID:        wallet.HoldID(client.Name, req.Key),
+			Key:       req.Key,
+			Client:    client.Name,
+			Owner:     req.Owner,
+			Reference: req.Reference,
+			Amount:    req.Amount,
+			Currency:  req.Currency,
+			Status:    wallet.HoldStatusHeld,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if _, err := s.db.Collection(HoldCollection).InsertOne(ctx, hold); err != nil {
+			return fmt.Errorf("unable to store the hold: %v: %w", err, wallet.ErrInternal)
+		}
+		return postHold(ctx, s.db, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// Capture implements wallet.HoldService. The difference with the held amount
+// is paid or given back. Capturing again the same amount returns the hold.
+func (s *HoldService) Capture(ctx context.Context, key string, req wallet.CaptureRequest) (_ *wallet.Hold, err error) {
+	defer derrors.Wrap(&err, "mongo.HoldService.Capture")
+	client := wallet.ClientFromContext(ctx)
+	if client == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var hold *wallet.Hold
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		hold, err = findHold(ctx, s.db, wallet.HoldID(client.Name, key))
+		if errors.Is(err, wallet.ErrNotFound) {
+			hold, err = s.pay(ctx, client, key, req)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if err := req.Check(hold); err != nil {
+			return err
+		}
+		switch hold.Status {
+		case wallet.HoldStatusCaptured:
+			if hold.Captured != req.Amount {
+				return errHoldCaptured(hold)
+			}
+			return nil
+		case wallet.HoldStatusReleased:
+			return errHoldReleased(hold)
+		}
+		hold.Captured = req.Amount
+		hold.Status = wallet.HoldStatusCaptured
+		if err := updateHold(ctx, s.db, hold, wallet.HoldStatusHeld); err != nil {
+			return err
+		}
+		// the held amount goes back to the wallet and the captured one is
+		// paid from it, so only the difference changes the balance
+		e := wallet.NewEntry(wallet.TransferTypePayment, hold.Currency,
+			wallet.Posting{Account: wallet.AccountHolds, Amount: -hold.Amount},
+			wallet.Posting{Account: wallet.WalletAccount(hold.Owner), Amount: hold.Amount},
+			wallet.Posting{Account: wallet.WalletAccount(hold.Owner), Amount: -hold.Captured},
+			wallet.Posting{Account: wallet.ClearingAccount(hold.Client), Amount: hold.Captured},
+		)
+		return postHoldEntry(ctx, s.db, hold, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// pay captures the amount from the balance of a key without hold.
+func (s *HoldService) pay(ctx context.Context, client *wallet.Client, key string, req wallet.CaptureRequest) (*wallet.Hold, error) {
+	if req.Owner == "" {
+		return nil, wallet.NewMissingParameter("owner")
+	}
+	if req.Currency == "" {
+		return nil, wallet.NewMissingParameter("currency")
+	}
+	now := uint(wallet.Now().UTC().Unix())
+	hold := &wallet.Hold{
+		ID:        wallet.HoldID(client.Name, key),
+		Key:       key,
+		Client:    client.Name,
+		Owner:     req.Owner,
+		Reference: req.Reference,
+		Captured:  req.Amount,
+		Currency:  req.Currency,
+		Status:    wallet.HoldStatusCaptured,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := s.db.Collection(HoldCollection).InsertOne(ctx, hold); err != nil {
+		return nil, fmt.Errorf("unable to store the hold: %v: %w", err, wallet.ErrInternal)
+	}
+	if hold.Captured == 0 {
+		return hold, nil
+	}
+	e := wallet.NewEntry(wallet.TransferTypePayment, hold.Currency,
+		wallet.Posting{Account: wallet.WalletAccount(hold.Owner), Amount: -hold.Captured},
+		wallet.Posting{Account: wallet.ClearingAccount(hold.Client), Amount: hold.Captured},
+	)
+	return hold, postHoldEntry(ctx, s.db, hold, e)
+}
+
+// Release implements wallet.HoldService. Releasing again returns the hold.
+func (s *HoldService) Release(ctx context.Context, key string) (_ *wallet.Hold, err error) {
+	defer derrors.Wrap(&err, "mongo.HoldService.Release")
+	client := wallet.ClientFromContext(ctx)
+	if client == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	var hold *wallet.Hold
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		hold, err = findHold(ctx, s.db, wallet.HoldID(client.Name, key))
+		if err != nil {
+			return err
+		}
+		switch hold.Status {
+		case wallet.HoldStatusReleased:
+			return nil
+		case wallet.HoldStatusCaptured:
+			return errHoldCaptured(hold)
+		}
+		hold.Status = wallet.HoldStatusReleased
+		if err := updateHold(ctx, s.db, hold, wallet.HoldStatusHeld); err != nil {
+			return err
+		}
+		e := wallet.NewEntry(wallet.TransferTypeRelease, hold.Currency,
+			wallet.Posting{Account: wallet.AccountHolds, Amount: -hold.Amount},
+			wallet.Posting{Account: wallet.WalletAccount(hold.Owner), Amount: hold.Amount},
+		)
+		return postHoldEntry(ctx, s.db, hold, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+func findHold(ctx context.Context, db *DB, id string) (*wallet.Hold, error) {
+	var hold wallet.Hold
+	err := db.Collection(HoldCollection).FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&hold)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("unable to find hold %s: %w", id, wallet.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to find hold: %v: %w", err, wallet.ErrInternal)
+	}
+	return &hold, nil
+}
+
+// updateHold stores the hold if it is still in the given status.
+func updateHold(ctx context.Context, db *DB, hold *wallet.Hold, status wallet.HoldStatus) error {
+	hold.UpdatedAt = uint(wallet.Now().UTC().Unix())
+	f := bson.D{{Key: "_id", Value: hold.ID}, {Key: "status", Value: status}}
+	res, err := db.Collection(HoldCollection).ReplaceOne(ctx, f, hold)
+	if err != nil {
+		return fmt.Errorf("unable to update hold: %v: %w", err, wallet.ErrInternal)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("hold %s was modified concurrently: %w", hold.Key, wallet.ErrConflict)
+	}
+	return nil
+}
+
+func errHoldCaptured(h *wallet.Hold) error {
+	return fmt.Errorf("hold %s was captured: %w", h.Key, wallet.ErrConflict)
+}
+
+func errHoldReleased(h *wallet.Hold) error {
+	return fmt.Errorf("hold %s was released: %w", h.Key, wallet.ErrConflict)
+}
+
+// postHold posts the entry taking the held amount off the wallet.
+func postHold(ctx context.Context, db *DB, hold *wallet.Hold) error {
+	e := wallet.NewEntry(wallet.TransferTypeHold, hold.Currency,
+		wallet.Posting{Account: wallet.WalletAccount(hold.Owner), Amount: -hold.Amount},
+		wallet.Posting{Account: wallet.AccountHolds, Amount: hold.Amount},
+	)
+	return postHoldEntry(ctx, db, hold, e)
+}
+
+// postHoldEntry posts the entry of a change of the hold.
+func postHoldEntry(ctx context.Context, db *DB, hold *wallet.Hold, e *wallet.Entry) error {
+	e.From = hold.Owner
+	e.To = hold.Client
+	e.Reference = hold.Reference
+	return postEntry(ctx, db, e)
+}
@@ -0,0 +1,109 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wallet.io/pkg/wallet"
+)
+
+func TestHoldServiceCapture(t *testing.T) {
+	ctx := prepateContext(t)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(HoldCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+	holds := NewHoldService(db)
+
+	if _, err := s.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	user := wallet.UserFromContext(ctx)
+	if err := s.Deposit(prepateContext(t, wallet.RoleAdmin), user.ID, 1000, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	client := wallet.NewContextWithClient(context.Background(), &wallet.Client{Name: "order"})
+	req := wallet.HoldRequest{Key: "order-1", Owner: user.ID, Amount: 600, Currency: "CUP"}
+
+	if _, err := holds.Hold(ctx, req); !errors.Is(err, wallet.ErrAccessDenied) {
+		t.Fatalf("HoldService.Hold() without client error = %v", err)
+	}
+	if _, err := holds.Hold(client, req); err != nil {
+		t.Fatal(err)
+	}
+	// the same key does not hold twice
+	if _, err := holds.Hold(client, req); err != nil {
+		t.Fatal(err)
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != 400 {
+		t.Fatalf("balance after hold = %v, want 400", balance.Amount["CUP"])
+	}
+	if _, err := holds.Hold(client, wallet.HoldRequest{Key: "order-2", Owner: user.ID, Amount: 600, Currency: "CUP"}); !errors.Is(err, wallet.ErrInsufficientFunds) {
+		t.Fatalf("HoldService.Hold() over the balance error = %v", err)
+	}
+
+	hold, err := holds.Capture(client, "order-1", wallet.CaptureRequest{Amount: 700})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hold.Status != wallet.HoldStatusCaptured || hold.Captured != 700 {
+		t.Fatalf("HoldService.Capture() = %+v", hold)
+	}
+	if _, err := holds.Capture(client, "order-1", wallet.CaptureRequest{Amount: 700}); err != nil {
+		t.Fatal(err)
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != 300 {
+		t.Fatalf("balance after capture = %v, want 300", balance.Amount["CUP"])
+	}
+	if _, err := holds.Release(client, "order-1"); !errors.Is(err, wallet.ErrConflict) {
+		t.Fatalf("HoldService.Release() of a captured hold error = %v", err)
+	}
+}
+
+func TestHoldServiceRelease(t *testing.T) {
+	ctx := prepateContext(t)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(HoldCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+	holds := NewHoldService(db)
+
+	if _, err := s.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	user := wallet.UserFromContext(ctx)
+	if err := s.Deposit(prepateContext(t, wallet.RoleAdmin), user.ID, 1000, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	client := wallet.NewContextWithClient(context.Background(), &wallet.Client{Name: "order"})
+
+	if _, err := holds.Hold(client, wallet.HoldRequest{Key: "order-1", Owner: user.ID, Amount: 600, Currency: "CUP"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		hold, err := holds.Release(client, "order-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hold.Status != wallet.HoldStatusReleased {
+			t.Fatalf("HoldService.Release() = %+v", hold)
+		}
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != 1000 {
+		t.Fatalf("balance after release = %v, want 1000", balance.Amount["CUP"])
+	}
+	if _, err := holds.Capture(client, "order-1", wallet.CaptureRequest{Amount: 600}); !errors.Is(err, wallet.ErrConflict) {
+		t.Fatalf("HoldService.Capture() of a released hold error = %v", err)
+	}
+}
@@ -0,0 +1,350 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"wallet.io/pkg/derrors"
+	"wallet.io/pkg/wallet"
+)
+
+// JournalCollection holds the entries of the ledger. They are only ever
+// inserted.
+const JournalCollection Collections = "journal"
+
+var _ wallet.LedgerService = (*LedgerService)(nil)
+
+type LedgerService struct {
+	db *DB
+}
+
+// NewLedgerService returns the ledger service. The journal indexes are
+// created by OpenLedger.
+func NewLedgerService(db *DB) *LedgerService {
+	return &LedgerService{db: db}
+}
+
+// Balance implements wallet.LedgerService.
+func (s *LedgerService) Balance(ctx context.Context, account, currency string) (_ int64, err error) {
+	defer derrors.Wrap(&err, "mongo.LedgerService.Balance")
+	user := wallet.UserFromContext(ctx)
+	if user == nil || user.Role != wallet.RoleAdmin {
+		return 0, wallet.ErrAccessDenied
+	}
+	balances, err := ledgerBalances(ctx, s.db, account)
+	if err != nil {
+		return 0, err
+	}
+	return balances[currency], nil
+}
+
+// Reconcile implements wallet.LedgerService. The check runs in a transaction,
+// so a posting made meanwhile makes it start again instead of being lost.
+func (s *LedgerService) Reconcile(ctx context.Context, owner string) (_ *wallet.Reconciliation, err error) {
+	defer derrors.Wrap(&err, "mongo.LedgerService.Reconcile")
+	user := wallet.UserFromContext(ctx)
+	if user == nil || user.Role != wallet.RoleAdmin {
+		return nil, wallet.ErrAccessDenied
+	}
+	account := wallet.WalletAccount(owner)
+	var rec *wallet.Reconciliation
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		rec = &wallet.Reconciliation{Account: account}
+		var w wallet.Wallet
+		err := s.db.Collection(WalletCollection).FindOne(ctx, bson.D{{Key: "owner._id", Value: owner}}).Decode(&w)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("wallet of %s: %w", owner, wallet.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to find wallet: %v: %w", err, wallet.ErrInternal)
+		}
+		ledger, err := ledgerBalances(ctx, s.db, account)
+		if err != nil {
+			return err
+		}
+		set := bson.D{}
+		for currency, amount := range ledger {
+			if w.Balance.Amount[currency] != amount {
+				rec.Drifts = append(rec.Drifts, wallet.Drift{Currency: currency, Cached: w.Balance.Amount[currency], Ledger: amount})
+				set = append(set, bson.E{Key: "balance.balance." + currency, Value: amount})
+			}
+		}
+		for currency, amount := range w.Balance.Amount {
+			if _, ok := ledger[currency]; !ok && amount != 0 {
+				rec.Drifts = append(rec.Drifts, wallet.Drift{Currency: currency, Cached: amount})
+				set = append(set, bson.E{Key: "balance.balance." + currency, Value: int64(0)})
+			}
+		}
+		if len(set) == 0 {
+			return nil
+		}
+		if _, err := s.db.Collection(WalletCollection).UpdateOne(ctx, bson.D{{Key: "_id", Value: w.ID}}, bson.D{{Key: "$set", Value: set}}); err != nil {
+			return fmt.Errorf("unable to fix balance: %v: %w", err, wallet.ErrInternal)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// OpenLedger posts the entries of the wallets created before the ledger, so
+// their balances and transactions are backed by the journal. The transfer
+// events they kept are posted as entries at their time, and an opening entry
+// posts the rest of the balance. Opened wallets are skipped, so it can run on
+// every start.
+func OpenLedger(ctx context.Context, db *DB) error {
+	if err := createJournalIndexes(ctx, db); err != nil {
+		return err
+	}
+	cur, err := db.Collection(WalletCollection).Find(ctx, bson.D{{Key: "ledger", Value: bson.D{{Key: "$ne", Value: true}}}})
+	if err != nil {
+		return fmt.Errorf("unable to find wallets: %v: %w", err, wallet.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var w struct {
+			wallet.Wallet `bson:",inline"`
+			// History is the transfer_event list the wallets kept before the
+			// ledger.
+			History []wallet.TransferEvent `bson:"transfer_event"`
+		}
+		if err := cur.Decode(&w); err != nil {
+			return fmt.Errorf("unable to decode wallet: %v: %w", err, wallet.ErrInternal)
+		}
+		account := wallet.WalletAccount(w.Owner.ID)
+		var entries []any
+		rest := make(map[string]int64, len(w.Balance.Amount))
+		for currency, amount := range w.Balance.Amount {
+			rest[currency] = amount
+		}
+		for i, event := range w.History {
+			amount := event.Amount
+			if event.Type == wallet.TransferTypeWithdraw {
+				amount = -amount
+			}
+			e := wallet.NewEntry(event.Type, event.Currency,
+				wallet.Posting{Account: account, Amount: amount},
+				wallet.Posting{Account: wallet.AccountOpening, Amount: -amount},
+			)
+			if len(e.Postings) == 0 {
+				continue
+			}
+			e.Key = "history:" + w.ID + ":" + strconv.Itoa(i)
+			e.From, e.To = event.From, event.To
+			e.Overdraft = true
+			e.CreatedAt = event.CreatedAt
+			entries = append(entries, e)
+			rest[event.Currency] -= amount
+		}
+		for currency, amount := range rest {
+			e := wallet.NewEntry(wallet.TransferTypeDeposit, currency,
+				wallet.Posting{Account: account, Amount: amount},
+				wallet.Posting{Account: wallet.AccountOpening, Amount: -amount},
+			)
+			if len(e.Postings) == 0 {
+				continue
+			}
+			e.Key = "opening:" + w.ID + ":" + currency
+			e.Overdraft = true
+			e.CreatedAt = w.CreatedAt
+			entries = append(entries, e)
+		}
+		err := withTransaction(ctx, db, func(ctx context.Context) error {
+			// the balance is already cached
+			if len(entries) > 0 {
+				if _, err := db.Collection(JournalCollection).InsertMany(ctx, entries); err != nil {
+					return fmt.Errorf("unable to open the ledger of %s: %v: %w", w.ID, err, wallet.ErrInternal)
+				}
+			}
+			update := bson.D{{Key: "$set", Value: bson.D{{Key: "ledger", Value: true}}}}
+			if _, err := db.Collection(WalletCollection).UpdateOne(ctx, bson.D{{Key: "_id", Value: w.ID}}, update); err != nil {
+				return fmt.Errorf("unable to open the ledger of %s: %v: %w", w.ID, err, wallet.ErrInternal)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return fmt.Errorf("unable to iterate over wallets: %v: %w", err, wallet.ErrInternal)
+	}
+	return nil
+}
+
+func createJournalIndexes(ctx context.Context, db *DB) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "postings.account", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "key", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	}
+	if _, err := db.Collection(JournalCollection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("unable to create journal indexes: %v: %w", err, wallet.ErrInternal)
+	}
+	return nil
+}
+
+// postEntry stores the entry and applies its postings to the cached balances
+// of the wallets. It must run in a transaction, so the journal and the
+// balances change together. A wallet without the funds of a debit fails with
+// wallet.ErrInsufficientFunds, unless the entry allows an overdraft.
+func postEntry(ctx context.Context, db *DB, e *wallet.Entry) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	if _, err := db.Collection(JournalCollection).InsertOne(ctx, e); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("entry %s was already posted: %w", e.Key, wallet.ErrConflict)
+		}
+		return fmt.Errorf("unable to post entry: %v: %w", err, wallet.ErrInternal)
+	}
+	for _, p := range e.Postings {
+		owner, ok := wallet.WalletOwner(p.Account)
+		if !ok {
+			continue
+		}
+		field := "balance.balance." + e.Currency
+		f := bson.D{{Key: "owner._id", Value: owner}}
+		guarded := p.Amount < 0 && !e.Overdraft
+		if guarded {
+			f = append(f, bson.E{Key: field, Value: bson.D{{Key: "$gte", Value: -p.Amount}}})
+		}
+		update := bson.D{
+			{Key: "$inc", Value: bson.D{{Key: field, Value: p.Amount}}},
+			{Key: "$set", Value: bson.D{{Key: "updated_at", Value: e.CreatedAt}}},
+		}
+		res, err := db.Collection(WalletCollection).UpdateOne(ctx, f, update)
+		if err != nil {
+			return fmt.Errorf("unable to update balance: %v: %w", err, wallet.ErrInternal)
+		}
+		if res.MatchedCount == 0 {
+			if guarded {
+				return fmt.Errorf("insufficient funds: %w", wallet.ErrInsufficientFunds)
+			}
+			return fmt.Errorf("wallet of %s: %w", owner, wallet.ErrNotFound)
+		}
+	}
+	return nil
+}
+
+// findEntryByKey returns the entry posted for the request key.
+func findEntryByKey(ctx context.Context, db *DB, key string) (*wallet.Entry, error) {
+	var e wallet.Entry
+	err := db.Collection(JournalCollection).FindOne(ctx, bson.D{{Key: "key", Value: key}}).Decode(&e)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("unable to find entry %s: %w", key, wallet.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to find entry: %v: %w", err, wallet.ErrInternal)
+	}
+	return &e, nil
+}
+
+// findEntries returns a page of the entries of the account, newest first, and
+// the token of the next one.
+func findEntries(ctx context.Context, db *DB, account string, filter wallet.TransactionFilter) ([]*wallet.Entry, string, error) {
+	var entries []*wallet.Entry
+	var token string
+	f := bson.D{{Key: "postings.account", Value: account}}
+	if filter.Token != "" {
+		createdAt, id, err := parseEntryToken(filter.Token)
+		if err != nil {
+			return nil, "", err
+		}
+		f = append(f, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "created_at", Value: bson.D{{Key: "$lt", Value: createdAt}}}},
+			bson.D{{Key: "created_at", Value: createdAt}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: id}}}},
+		}})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit + 1))
+	}
+	cur, err := db.Collection(JournalCollection).Find(ctx, f, opts)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to find entries: %v: %w", err, wallet.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var e wallet.Entry
+		if err := cur.Decode(&e); err != nil {
+			return nil, "", fmt.Errorf("unable to decode entry: %v: %w", err, wallet.ErrInternal)
+		}
+		entries = append(entries, &e)
+		if len(entries) == filter.Limit+1 && filter.Limit > 0 {
+			last := entries[filter.Limit-1]
+			token = fmt.Sprintf("%d:%s", last.CreatedAt, last.ID)
+			entries = entries[:filter.Limit]
+			break
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, "", fmt.Errorf("unable to iterate over entries: %v: %w", err, wallet.ErrInternal)
+	}
+	return entries, token, nil
+}
+
+func parseEntryToken(token string) (uint, string, error) {
+	createdAt, id, ok := strings.Cut(token, ":")
+	if !ok {
+		return 0, "", wallet.NewInvalidParameter("token", token)
+	}
+	t, err := strconv.ParseUint(createdAt, 10, 64)
+	if err != nil {
+		return 0, "", wallet.NewInvalidParameter("token", token)
+	}
+	return uint(t), id, nil
+}
+
+// ledgerBalances returns the balances of the account by currency, summing its
+// postings in the journal.
+func ledgerBalances(ctx context.Context, db *DB, account string) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "postings.account", Value: account}}}},
+		{{Key: "$unwind", Value: "$postings"}},
+		{{Key: "$match", Value: bson.D{{Key: "postings.account", Value: account}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$currency"},
+			{Key: "balance", Value: bson.D{{Key: "$sum", Value: "$postings.amount"}}},
+		}}},
+	}
+	cur, err := db.Collection(JournalCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sum the postings: %v: %w", err, wallet.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var rows []struct {
+		Currency string `bson:"_id"`
+		Balance  int64  `bson:"balance"`
+	}
+	if err := cur.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("unable to decode balances: %v: %w", err, wallet.ErrInternal)
+	}
+	balances := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		balances[r.Currency] = r.Balance
+	}
+	return balances, nil
+}
+
+// withTransaction runs fn in a transaction, so the journal and the documents
+// it backs are updated together.
+func withTransaction(ctx context.Context, db *DB, fn func(context.Context) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("unable to start session: %v: %w", err, wallet.ErrInternal)
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
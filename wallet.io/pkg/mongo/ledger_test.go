@@ -0,0 +1,158 @@
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"wallet.io/pkg/wallet"
+)
+
+func TestWalletServiceTransactions(t *testing.T) {
+	ctx := prepateContext(t, wallet.RoleDriver)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+	ledger := NewLedgerService(db)
+
+	if _, err := s.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	user := wallet.UserFromContext(ctx)
+	admin := prepateContext(t, wallet.RoleAdmin)
+	for i := 0; i < 3; i++ {
+		if err := s.Deposit(admin, user.ID, 100, "CUP"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Withdraw(ctx, 250, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Withdraw(ctx, 100, "CUP"); err == nil {
+		t.Fatal("WalletService.Withdraw() over the balance succeeded")
+	}
+
+	var all []wallet.TransferEvent
+	filter := wallet.TransactionFilter{Limit: 3}
+	for {
+		page, token, err := s.Transactions(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, page...)
+		if token == "" {
+			break
+		}
+		filter.Token = token
+	}
+	if len(all) != 4 || all[0].Type != wallet.TransferTypeWithdraw || all[0].Amount != -250 {
+		t.Fatalf("WalletService.Transactions() = %+v, want the withdrawal and the 3 deposits", all)
+	}
+	if balance, err := ledger.Balance(admin, wallet.AccountPayouts, "CUP"); err != nil || balance != 250 {
+		t.Fatalf("LedgerService.Balance() of the payouts = %v, %v, want 250", balance, err)
+	}
+	if _, err := ledger.Balance(ctx, wallet.AccountPayouts, "CUP"); err == nil {
+		t.Fatal("LedgerService.Balance() available to a driver")
+	}
+}
+
+func TestLedgerServiceReconcile(t *testing.T) {
+	ctx := prepateContext(t)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+	ledger := NewLedgerService(db)
+
+	if _, err := s.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	user := wallet.UserFromContext(ctx)
+	admin := prepateContext(t, wallet.RoleAdmin)
+	if err := s.Deposit(admin, user.ID, 100, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	// the cached balance drifts from the ledger
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "balance.balance.CUP", Value: int64(130)}}}}
+	if _, err := db.Collection(WalletCollection).UpdateOne(ctx, bson.D{{Key: "owner._id", Value: user.ID}}, update); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := ledger.Reconcile(admin, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Drifts) != 1 || rec.Drifts[0].Cached != 130 || rec.Drifts[0].Ledger != 100 {
+		t.Fatalf("LedgerService.Reconcile() = %+v, want the CUP drift", rec)
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != 100 {
+		t.Fatalf("balance after reconcile = %v, want 100", balance.Amount["CUP"])
+	}
+	if rec, err := ledger.Reconcile(admin, user.ID); err != nil || len(rec.Drifts) != 0 {
+		t.Fatalf("LedgerService.Reconcile() again = %+v, %v, want no drift", rec, err)
+	}
+}
+
+func TestOpenLedger(t *testing.T) {
+	ctx := prepateContext(t)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+
+	// a wallet kept before the ledger, with its history
+	user := wallet.UserFromContext(ctx)
+	old := bson.D{
+		{Key: "_id", Value: wallet.NewID().String()},
+		{Key: "owner", Value: bson.D{{Key: "_id", Value: user.ID}}},
+		{Key: "balance", Value: bson.D{{Key: "balance", Value: bson.D{{Key: "CUP", Value: int64(180)}}}}},
+		{Key: "created_at", Value: uint(100)},
+		{Key: "transfer_event", Value: bson.A{
+			bson.D{{Key: "type", Value: wallet.TransferTypeDeposit}, {Key: "amount", Value: int64(200)}, {Key: "currency", Value: "CUP"}, {Key: "created_at", Value: uint(200)}},
+			bson.D{{Key: "type", Value: wallet.TransferTypeWithdraw}, {Key: "amount", Value: int64(50)}, {Key: "currency", Value: "CUP"}, {Key: "created_at", Value: uint(300)}},
+		}},
+	}
+	if _, err := db.Collection(WalletCollection).InsertOne(ctx, old); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := OpenLedger(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, _, err := s.Transactions(ctx, wallet.TransactionFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the history and the opening of the balance it does not explain
+	want := []int64{-50, 200, 30}
+	if len(all) != len(want) {
+		t.Fatalf("WalletService.Transactions() = %+v, want %v", all, want)
+	}
+	for i, tx := range all {
+		if tx.Amount != want[i] {
+			t.Fatalf("WalletService.Transactions() = %+v, want %v", all, want)
+		}
+	}
+	balances, err := ledgerBalances(ctx, db, wallet.WalletAccount(user.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balances["CUP"] != 180 {
+		t.Fatalf("ledger balance = %v, want 180", balances["CUP"])
+	}
+}
@@ -0,0 +1,94 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"wallet.io/pkg/derrors"
+	"wallet.io/pkg/wallet"
+)
+
+var _ wallet.SettlementService = (*SettlementService)(nil)
+
+type SettlementService struct {
+	db *DB
+}
+
+func NewSettlementService(db *DB) *SettlementService {
+	return &SettlementService{db: db}
+}
+
+// Settle implements wallet.SettlementService. The fare is split into the
+// revenue of the platform and the wallet of the driver, in an entry posted
+// once per key. The part captured from the wallet of the rider comes from the
+// clearing account of the client and the rest from the charges account.
+// Drivers without a wallet get one.
+func (s *SettlementService) Settle(ctx context.Context, req wallet.SettlementRequest) (_ *wallet.TransferEvent, err error) {
+	defer derrors.Wrap(&err, "mongo.SettlementService.Settle")
+	client := wallet.ClientFromContext(ctx)
+	if client == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	account := wallet.WalletAccount(req.Owner)
+	var e *wallet.Entry
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		e, err = findEntryByKey(ctx, s.db, wallet.SettlementID(client.Name, req.Key))
+		if err == nil {
+			if e.Type != wallet.TransferTypeEarnings || e.Amount(account) != req.Split().Net() || e.Currency != req.Currency {
+				return fmt.Errorf("settlement %s was applied with other amounts: %w", req.Key, wallet.ErrConflict)
+			}
+			return nil
+		}
+		if !errors.Is(err, wallet.ErrNotFound) {
+			return err
+		}
+		if err := ensureWallet(ctx, s.db, req.Owner); err != nil {
+			return err
+		}
+		// the cash collected by the driver never reached the clearing
+		// account, the driver owes it instead
+		e = wallet.NewEntry(wallet.TransferTypeEarnings, req.Currency,
+			wallet.Posting{Account: wallet.ClearingAccount(client.Name), Amount: -req.Captured},
+			wallet.Posting{Account: wallet.AccountCharges, Amount: -(req.Fare - req.Collected - req.Captured)},
+			wallet.Posting{Account: wallet.AccountRevenue, Amount: req.Commission},
+			wallet.Posting{Account: account, Amount: req.Earnings},
+			wallet.Posting{Account: account, Amount: -req.Collected},
+		)
+		e.Key = wallet.SettlementID(client.Name, req.Key)
+		e.From = client.Name
+		e.To = req.Owner
+		e.Reference = req.Reference
+		e.Earnings = req.Split()
+		e.Overdraft = true
+		return postEntry(ctx, s.db, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := e.Transaction(account)
+	return &t, nil
+}
+
+// ensureWallet creates the wallet of the owner if it has none.
+func ensureWallet(ctx context.Context, db *DB, owner string) error {
+	w := wallet.NewWallet()
+	update := bson.D{{Key: "$setOnInsert", Value: bson.D{
+		{Key: "_id", Value: w.ID},
+		{Key: "balance", Value: w.Balance},
+		{Key: "created_at", Value: w.CreatedAt},
+		{Key: "updated_at", Value: w.UpdatedAt},
+		{Key: "ledger", Value: true},
+	}}}
+	opts := options.Update().SetUpsert(true)
+	if _, err := db.Collection(WalletCollection).UpdateOne(ctx, bson.D{{Key: "owner._id", Value: owner}}, update, opts); err != nil {
+		return fmt.Errorf("unable to create wallet: %v: %w", err, wallet.ErrInternal)
+	}
+	return nil
+}
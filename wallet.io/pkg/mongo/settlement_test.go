@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wallet.io/pkg/wallet"
+)
+
+func TestSettlementServiceSettle(t *testing.T) {
+	ctx := prepateContext(t)
+
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx)
+		db.Collection(JournalCollection).Drop(ctx)
+		db.client.Disconnect(ctx)
+	}()
+	s := NewWalletService(db)
+	settlements := NewSettlementService(db)
+
+	user := wallet.UserFromContext(ctx)
+	client := wallet.NewContextWithClient(context.Background(), &wallet.Client{Name: "order"})
+	req := wallet.SettlementRequest{Key: "order-1", Owner: user.ID, Reference: "order-1", Fare: 1000, Commission: 200, Earnings: 800, Currency: "CUP"}
+
+	if _, err := settlements.Settle(ctx, req); !errors.Is(err, wallet.ErrAccessDenied) {
+		t.Fatalf("SettlementService.Settle() without client error = %v", err)
+	}
+	// the wallet of the driver is created by the first settlement and the
+	// same key is settled once
+	for i := 0; i < 2; i++ {
+		transfer, err := settlements.Settle(client, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transfer.Type != wallet.TransferTypeEarnings || transfer.Amount != 800 || transfer.Earnings.Commission != 200 {
+			t.Fatalf("SettlementService.Settle() = %+v", transfer)
+		}
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != 800 {
+		t.Fatalf("balance after settlement = %v, want 800", balance.Amount["CUP"])
+	}
+
+	// the cash collected is owed to the platform
+	cash := wallet.SettlementRequest{Key: "order-2", Owner: user.ID, Fare: 5000, Commission: 1000, Earnings: 4000, Collected: 5000, Currency: "CUP"}
+	if _, err := settlements.Settle(client, cash); err != nil {
+		t.Fatal(err)
+	}
+	if balance, _ := s.Balance(ctx); balance.Amount["CUP"] != -200 {
+		t.Fatalf("balance after cash settlement = %v, want -200", balance.Amount["CUP"])
+	}
+	transactions, _, err := s.Transactions(ctx, wallet.TransactionFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 2 || transactions[0].Earnings == nil || transactions[0].Earnings.Collected != 5000 || transactions[0].Amount != -1000 {
+		t.Fatalf("transactions = %+v, want the two earnings", transactions)
+	}
+
+	// only the fare captured from the wallet of the rider leaves the
+	// clearing account
+	captured := wallet.SettlementRequest{Key: "order-3", Owner: user.ID, Fare: 1000, Commission: 200, Earnings: 800, Captured: 900, Currency: "CUP"}
+	if _, err := settlements.Settle(client, captured); err != nil {
+		t.Fatal(err)
+	}
+	admin := prepateContext(t, wallet.RoleAdmin)
+	ledger := NewLedgerService(db)
+	if balance, err := ledger.Balance(admin, wallet.ClearingAccount("order"), "CUP"); err != nil || balance != -900 {
+		t.Fatalf("LedgerService.Balance() of the clearing = %v, %v, want -900", balance, err)
+	}
+	if balance, err := ledger.Balance(admin, wallet.AccountCharges, "CUP"); err != nil || balance != -1100 {
+		t.Fatalf("LedgerService.Balance() of the charges = %v, %v, want -1100", balance, err)
+	}
+
+	cash.Earnings = 4500
+	if _, err := settlements.Settle(client, cash); !errors.Is(err, wallet.ErrInvalid) {
+		t.Fatalf("SettlementService.Settle() with wrong earnings error = %v", err)
+	}
+}
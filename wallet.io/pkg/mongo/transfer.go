@@ -0,0 +1,308 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"wallet.io/pkg/derrors"
+	"wallet.io/pkg/wallet"
+)
+
+const TransferCollection Collections = "transfers"
+
+// Transfer implements wallet.WalletService. The balance is checked to refuse
+// the transfers the sender cannot pay, but nothing moves until it is
+// confirmed.
+func (s *WalletService) Transfer(ctx context.Context, to string, amount int64, currency string) (_ *wallet.Transfer, err error) {
+	defer derrors.Wrap(&err, "mongo.WalletService.Transfer")
+	user := wallet.UserFromContext(ctx)
+	if user == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	if to == "" {
+		return nil, wallet.NewMissingParameter("to")
+	}
+	recipient, err := findRecipient(ctx, s.db, to)
+	if err != nil {
+		return nil, err
+	}
+	transfer := wallet.NewTransfer(user.ID, recipient.Owner.ID, amount, currency)
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+	sender, err := findWallet(ctx, s.db, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := syncOwner(ctx, s.db, sender, user); err != nil {
+		return nil, err
+	}
+	if !sender.CanTransfer(amount, currency) {
+		return nil, fmt.Errorf("insufficient funds: %w", wallet.ErrInsufficientFunds)
+	}
+	if _, err := s.db.Collection(TransferCollection).InsertOne(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("unable to store transfer: %v: %w", err, wallet.ErrInternal)
+	}
+	return transfer, nil
+}
+
+// ConfirmTransfer implements wallet.WalletService. The debit of the sender,
+// the credit of the recipient and the status of the transfer are written in
+// a single transaction, and the debit only if the balance still covers it.
+// A transfer the sender cannot pay anymore fails.
+func (s *WalletService) ConfirmTransfer(ctx context.Context, id, pin string) (_ *wallet.Transfer, err error) {
+	defer derrors.Wrap(&err, "mongo.WalletService.ConfirmTransfer")
+	user := wallet.UserFromContext(ctx)
+	if user == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	transfer, err := s.pendingTransfer(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	// the attempts are counted out of the transaction, so a wrong pin counts
+	if err := verifyPin(ctx, s.db, user.ID, pin); err != nil {
+		return nil, err
+	}
+	err = withTransaction(ctx, s.db, func(ctx context.Context) error {
+		e := wallet.NewEntry(wallet.TransferTypeTransfer, transfer.Currency,
+			wallet.Posting{Account: wallet.WalletAccount(transfer.From), Amount: -transfer.Amount},
+			wallet.Posting{Account: wallet.WalletAccount(transfer.To), Amount: transfer.Amount},
+		)
+		e.From = transfer.From
+		e.To = transfer.To
+		e.Reference = transfer.ID
+		confirmed := *transfer
+		confirmed.Entry = e.ID
+		if err := confirmed.Transition(wallet.TransferStatusConfirmed, "", wallet.Now().UTC()); err != nil {
+			return err
+		}
+		if err := updateTransfer(ctx, s.db, &confirmed); err != nil {
+			return err
+		}
+		if err := postEntry(ctx, s.db, e); err != nil {
+			return err
+		}
+		transfer = &confirmed
+		return nil
+	})
+	if errors.Is(err, wallet.ErrInsufficientFunds) {
+		if err := transfer.Transition(wallet.TransferStatusFailed, "insufficient funds", wallet.Now().UTC()); err == nil {
+			if err := updateTransfer(ctx, s.db, transfer); err != nil {
+				slog.Info("unable to fail transfer", "transfer", transfer.ID, "error", err)
+			}
+		}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// CancelTransfer implements wallet.WalletService.
+func (s *WalletService) CancelTransfer(ctx context.Context, id string) (_ *wallet.Transfer, err error) {
+	defer derrors.Wrap(&err, "mongo.WalletService.CancelTransfer")
+	user := wallet.UserFromContext(ctx)
+	if user == nil {
+		return nil, wallet.ErrAccessDenied
+	}
+	transfer, err := s.pendingTransfer(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := transfer.Transition(wallet.TransferStatusCancelled, "", wallet.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := updateTransfer(ctx, s.db, transfer); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// pendingTransfer returns the pending transfer of the sender. An expired one
+// fails.
+func (s *WalletService) pendingTransfer(ctx context.Context, user *wallet.User, id string) (*wallet.Transfer, error) {
+	transfer, err := findTransfer(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.From != user.ID {
+		return nil, wallet.ErrAccessDenied
+	}
+	now := wallet.Now().UTC()
+	if transfer.Expired(now) {
+		if err := transfer.Transition(wallet.TransferStatusFailed, wallet.TransferExpired, now); err != nil {
+			return nil, err
+		}
+		if err := updateTransfer(ctx, s.db, transfer); err != nil {
+			return nil, err
+		}
+	}
+	if transfer.Status != wallet.TransferStatusPending {
+		return nil, fmt.Errorf("transfer %s is %s: %w", transfer.ID, transfer.Status, wallet.ErrConflict)
+	}
+	return transfer, nil
+}
+
+// TransferExpirer fails the transfers not confirmed in time.
+type TransferExpirer struct {
+	db       *DB
+	interval time.Duration
+}
+
+func NewTransferExpirer(db *DB) *TransferExpirer {
+	return &TransferExpirer{
+		db:       db,
+		interval: time.Minute,
+	}
+}
+
+// Run expires the transfers every interval until the context is done.
+func (e *TransferExpirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := expireTransfers(ctx, e.db, now); err != nil {
+				slog.Info("unable to expire transfers", "error", err)
+			}
+		}
+	}
+}
+
+func expireTransfers(ctx context.Context, db *DB, now time.Time) error {
+	f := bson.D{
+		{Key: "status", Value: wallet.TransferStatusPending},
+		{Key: "expires_at", Value: bson.D{{Key: "$lte", Value: now.Unix()}}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: wallet.TransferStatusFailed},
+		{Key: "reason", Value: wallet.TransferExpired},
+		{Key: "updated_at", Value: uint(now.Unix())},
+	}}}
+	if _, err := db.Collection(TransferCollection).UpdateMany(ctx, f, update); err != nil {
+		return fmt.Errorf("unable to expire transfers: %v: %w", err, wallet.ErrInternal)
+	}
+	return nil
+}
+
+func findTransfer(ctx context.Context, db *DB, id string) (*wallet.Transfer, error) {
+	var transfer wallet.Transfer
+	err := db.Collection(TransferCollection).FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&transfer)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("unable to find transfer %s: %w", id, wallet.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to find transfer: %v: %w", err, wallet.ErrInternal)
+	}
+	return &transfer, nil
+}
+
+// updateTransfer stores the transfer if it is still pending.
+func updateTransfer(ctx context.Context, db *DB, transfer *wallet.Transfer) error {
+	f := bson.D{{Key: "_id", Value: transfer.ID}, {Key: "status", Value: wallet.TransferStatusPending}}
+	res, err := db.Collection(TransferCollection).ReplaceOne(ctx, f, transfer)
+	if err != nil {
+		return fmt.Errorf("unable to update transfer: %v: %w", err, wallet.ErrInternal)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("transfer %s is not pending anymore: %w", transfer.ID, wallet.ErrConflict)
+	}
+	return nil
+}
+
+// findRecipient returns the wallet of the user with the id, phone, email or
+// referral code. The contacts are the ones synced from the sessions of the
+// owner, so a wallet created by a deposit or a hold is only found by its id
+// until the owner uses the wallet.
+func findRecipient(ctx context.Context, db *DB, to string) (*wallet.Wallet, error) {
+	f := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "owner._id", Value: to}},
+		bson.D{{Key: "owner.phone", Value: to}},
+		bson.D{{Key: "owner.email", Value: to}},
+		bson.D{{Key: "owner.referral", Value: to}},
+	}}}
+	cur, err := db.Collection(WalletCollection).Find(ctx, f, options.Find().SetLimit(2))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find recipient: %v: %w", err, wallet.ErrInternal)
+	}
+	defer cur.Close(ctx)
+	var wallets []*wallet.Wallet
+	if err := cur.All(ctx, &wallets); err != nil {
+		return nil, fmt.Errorf("unable to decode recipient: %v: %w", err, wallet.ErrInternal)
+	}
+	switch len(wallets) {
+	case 0:
+		return nil, wallet.NewNotFound("recipient")
+	case 1:
+		return wallets[0], nil
+	}
+	return nil, wallet.NewError(wallet.ErrConflict, http.StatusConflict, "many wallets match the recipient, use its id")
+}
+
+// verifyPin checks the pin of the wallet of the owner. Every check is counted
+// before comparing, so parallel guesses cannot go over the limit, and the
+// wallet is locked after wallet.MaxPinAttempts failed ones in a row.
+func verifyPin(ctx context.Context, db *DB, owner, pin string) error {
+	now := wallet.Now().UTC()
+	collection := db.Collection(WalletCollection)
+	f := bson.D{
+		{Key: "owner._id", Value: owner},
+		{Key: "pin", Value: bson.D{{Key: "$ne", Value: nil}}},
+		{Key: "pin_locked_until", Value: bson.D{{Key: "$not", Value: bson.D{{Key: "$gt", Value: now.Unix()}}}}},
+	}
+	// only the checks of a set pin are counted
+	update := bson.D{{Key: "$inc", Value: bson.D{{Key: "pin_attempts", Value: 1}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var w wallet.Wallet
+	err := collection.FindOneAndUpdate(ctx, f, update, opts).Decode(&w)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		var current wallet.Wallet
+		err := collection.FindOne(ctx, bson.D{{Key: "owner._id", Value: owner}}).Decode(&current)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("wallet of %s: %w", owner, wallet.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to find wallet: %v: %w", err, wallet.ErrInternal)
+		}
+		if current.PIN == nil {
+			return wallet.NewError(wallet.ErrInvalidInput, http.StatusBadRequest, "set the pin of the wallet first")
+		}
+		return errPinLocked()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to check pin: %v: %w", err, wallet.ErrInternal)
+	}
+	set := bson.D{{Key: "pin_attempts", Value: 0}}
+	checkErr := w.ComparePin(pin)
+	if checkErr != nil {
+		if w.PinAttempts < wallet.MaxPinAttempts {
+			left := wallet.MaxPinAttempts - w.PinAttempts
+			return wallet.NewError(wallet.ErrInvalidInput, http.StatusBadRequest, fmt.Sprintf("invalid pin, %d attempts left", left))
+		}
+		set = append(set, bson.E{Key: "pin_locked_until", Value: uint(now.Add(wallet.PinLockout).Unix())})
+	}
+	if _, err := collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: w.ID}}, bson.D{{Key: "$set", Value: set}}); err != nil {
+		return fmt.Errorf("unable to store pin check: %v: %w", err, wallet.ErrInternal)
+	}
+	if checkErr != nil {
+		return errPinLocked()
+	}
+	return nil
+}
+
+func errPinLocked() error {
+	return wallet.NewError(wallet.ErrLocked, http.StatusLocked, fmt.Sprintf("too many invalid pins, try again in %v", wallet.PinLockout))
+}
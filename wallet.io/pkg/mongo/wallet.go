@@ -2,10 +2,8 @@ package mongo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/go-chi/jwtauth/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -33,17 +31,22 @@ func NewWalletService(db *DB) *WalletService {
 }
 
 // Transactions implements wallet.WalletService.
-func (s *WalletService) Transactions(ctx context.Context) (_ []wallet.TransferEvent, err error) {
+func (s *WalletService) Transactions(ctx context.Context, filter wallet.TransactionFilter) (_ []wallet.TransferEvent, _ string, err error) {
 	defer derrors.Wrap(&err, "mongo.WalletService.Transactions")
 	user := wallet.UserFromContext(ctx)
 	if user == nil {
-		return nil, wallet.ErrAccessDenied
+		return nil, "", wallet.ErrAccessDenied
 	}
-	w, err := findWallet(ctx, s.db, user.ID)
+	account := wallet.WalletAccount(user.ID)
+	entries, token, err := findEntries(ctx, s.db, account, filter)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return w.TransferEvent, nil
+	transactions := make([]wallet.TransferEvent, 0, len(entries))
+	for _, e := range entries {
+		transactions = append(transactions, e.Transaction(account))
+	}
+	return transactions, token, nil
 }
 
 func (s *WalletService) Create(ctx context.Context) (_ *wallet.Wallet, err error) {
@@ -69,6 +72,9 @@ func (s *WalletService) Wallet(ctx context.Context) (_ *wallet.Wallet, err error
 	if err != nil {
 		return nil, err
 	}
+	if err := syncOwner(ctx, s.db, w, user); err != nil {
+		return nil, err
+	}
 	return w, nil
 }
 
@@ -85,8 +91,14 @@ func (s *WalletService) Deposit(ctx context.Context, owner string, amount int64,
 	if amount <= 0 {
 		return fmt.Errorf("invalid amount: %w", wallet.ErrInvalidInput)
 	}
-	w.Deposit(amount, currency)
-	return updateWallet(ctx, s.db, w)
+	e := wallet.NewEntry(wallet.TransferTypeDeposit, currency,
+		wallet.Posting{Account: wallet.AccountDeposits, Amount: -amount},
+		wallet.Posting{Account: wallet.WalletAccount(w.Owner.ID), Amount: amount},
+	)
+	e.To = w.Owner.ID
+	return withTransaction(ctx, s.db, func(ctx context.Context) error {
+		return postEntry(ctx, s.db, e)
+	})
 }
 
 func (s *WalletService) Withdraw(ctx context.Context, amount int64, currency string) (err error) {
@@ -98,103 +110,19 @@ func (s *WalletService) Withdraw(ctx context.Context, amount int64, currency str
 	if user.Role != wallet.RoleDriver {
 		return wallet.ErrAccessDenied
 	}
-	w, err := findWallet(ctx, s.db, user.ID)
-	if err != nil {
-		return err
-	}
-	if w.Balance.Amount[currency]-amount < 0 {
-		return fmt.Errorf("insufficient funds: %w", wallet.ErrInsufficientFunds)
+	if amount <= 0 {
+		return fmt.Errorf("invalid amount: %w", wallet.ErrInvalidInput)
 	}
-	w.Withdraw(amount, currency)
+	// the payout clears the withdrawal once paid
 	// TODO: execute payout to the customer account in case of a driver
-	return updateWallet(ctx, s.db, w)
-}
-
-// Transfer implements wallet.WalletService.
-func (s *WalletService) Transfer(ctx context.Context, to string, amount int64, currency string) (_ *wallet.TransferEvent, err error) {
-	defer derrors.Wrap(&err, "mongo.WalletService.Transfer")
-	user := wallet.UserFromContext(ctx)
-	if user == nil {
-		return nil, wallet.ErrAccessDenied
-	}
-
-	fromW, err := findWallet(ctx, s.db, user.ID)
-	if err != nil {
-		return nil, err
-	}
-	if !fromW.CanTransfer(amount, currency) {
-		return nil, fmt.Errorf("insufficient funds: %w", wallet.ErrInsufficientFunds)
-	}
-
-	transferEvent := wallet.TransferEvent{
-		ID:        wallet.NewID().String(),
-		From:      user.ID,
-		To:        to,
-		Type:      wallet.TransferTypeTransfer,
-		Status:    wallet.TransferStatusPending,
-		Amount:    amount,
-		Currency:  currency,
-		CreatedAt: uint(wallet.Now().UTC().Unix()),
-	}
-
-	fromW.PendingTransfers = append(fromW.PendingTransfers, transferEvent)
-	return &transferEvent, updateWallet(ctx, s.db, fromW)
-}
-
-func (s *WalletService) ConfirmTransfer(ctx context.Context, id, pin string) (err error) {
-	defer derrors.Wrap(&err, "mongo.WalletService.ConfirmTransfer")
-	claim, err := claimFromContext(ctx)
-	if err != nil {
-		return err
-	}
-	userData := claim.String("user")
-	var user wallet.User
-	if err := json.Unmarshal([]byte(userData), &user); err != nil {
-		return wallet.ErrAccessDenied
-	}
-	fromW, err := findWallet(ctx, s.db, user.ID)
-	if err != nil {
-		return err
-	}
-	pendingTransfer, index := fromW.FindPendingTransfer(id)
-	if pendingTransfer == nil {
-		return fmt.Errorf("transfer not found: %w", wallet.ErrNotFound)
-	}
-	if !fromW.CanTransfer(pendingTransfer.Amount, pendingTransfer.Currency) {
-		return fmt.Errorf("insufficient funds: %w", wallet.ErrInsufficientFunds)
-	}
-	toW, err := findWallet(ctx, s.db, pendingTransfer.To)
-	if err != nil {
-		return err
-	}
-
-	if fromW.ID == toW.ID {
-		return fmt.Errorf("invalid transfer: %w", wallet.ErrInvalidInput)
-	}
-
-	fromW.Withdraw(pendingTransfer.Amount, pendingTransfer.Currency)
-	toW.Deposit(pendingTransfer.Amount, pendingTransfer.Currency)
-	fromW.TransferEvent = append(fromW.TransferEvent[:index], fromW.TransferEvent[index+1:]...)
-
-	tx, err := s.db.client.StartSession()
-	if err != nil {
-		return err
-	}
-	err = tx.StartTransaction()
-	if err != nil {
-		return fmt.Errorf("error starting transaction: %v: %w", err, wallet.ErrInternal)
-	}
-	err = updateWallet(ctx, s.db, fromW)
-	if err != nil {
-		tx.AbortTransaction(ctx)
-		return err
-	}
-	err = updateWallet(ctx, s.db, toW)
-	if err != nil {
-		tx.AbortTransaction(ctx)
-		return err
-	}
-	return tx.CommitTransaction(ctx)
+	e := wallet.NewEntry(wallet.TransferTypeWithdraw, currency,
+		wallet.Posting{Account: wallet.WalletAccount(user.ID), Amount: -amount},
+		wallet.Posting{Account: wallet.AccountPayouts, Amount: amount},
+	)
+	e.From = user.ID
+	return withTransaction(ctx, s.db, func(ctx context.Context) error {
+		return postEntry(ctx, s.db, e)
+	})
 }
 
 // Balance implements wallet.WalletService.
@@ -209,6 +137,9 @@ func (s *WalletService) Balance(ctx context.Context) (_ wallet.Balance, err erro
 	if err != nil {
 		return wallet.Balance{}, err
 	}
+	if err := syncOwner(ctx, s.db, w, user); err != nil {
+		return wallet.Balance{}, err
+	}
 	return w.Balance, nil
 }
 
@@ -223,8 +154,15 @@ func (s *WalletService) SetPin(ctx context.Context, old string, new string) (err
 	if err != nil {
 		return err
 	}
-	if err := w.ComparePin(old); err != nil && w.PIN != nil {
-		return fmt.Errorf("invalid pin: %w", wallet.ErrInvalidInput)
+	if err := syncOwner(ctx, s.db, w, user); err != nil {
+		return err
+	}
+	// the old pin is checked like the confirmations, so it cannot be
+	// guessed either
+	if w.PIN != nil {
+		if err := verifyPin(ctx, s.db, user.ID, old); err != nil {
+			return err
+		}
 	}
 	if err := w.SetPin(new); err != nil {
 		return err
@@ -241,25 +179,41 @@ func storeWallet(context context.Context, db *DB, w *wallet.Wallet) error {
 	return nil
 }
 
+// updateWallet stores the wallet but its balance, which only changes with
+// the postings of the ledger.
 func updateWallet(context context.Context, db *DB, w *wallet.Wallet) error {
 	collection := db.Collection(WalletCollection)
-	_, err := collection.UpdateOne(context, bson.M{"_id": w.ID}, bson.M{"$set": w})
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "pin", Value: w.PIN},
+		{Key: "currency", Value: w.Currency},
+		{Key: "updated_at", Value: uint(wallet.Now().UTC().Unix())},
+	}}}
+	_, err := collection.UpdateOne(context, bson.M{"_id": w.ID}, update)
 	if err != nil {
 		return fmt.Errorf("error updating wallet: %w", err)
 	}
 	return nil
 }
 
+// syncOwner stores the contacts of the user in the wallet, so the other
+// users find it with the current ones. Wallets are synced when their owner
+// opens them, checks the balance, sets the pin or sends a transfer.
+func syncOwner(ctx context.Context, db *DB, w *wallet.Wallet, user *wallet.User) error {
+	if w.Owner == *user {
+		return nil
+	}
+	w.Owner = *user
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "owner", Value: w.Owner}}}}
+	if _, err := db.Collection(WalletCollection).UpdateOne(ctx, bson.D{{Key: "_id", Value: w.ID}}, update); err != nil {
+		return fmt.Errorf("unable to update owner: %v: %w", err, wallet.ErrInternal)
+	}
+	return nil
+}
+
 func findWallet(context context.Context, db *DB, owner string) (*wallet.Wallet, error) {
 	collection := db.Collection(WalletCollection)
 	var w *wallet.Wallet
-	f := bson.D{
-		{Key: "$or", Value: []any{
-			bson.D{{Key: "owner._id", Value: owner}},
-			bson.D{{Key: "referer", Value: owner}},
-		}},
-	}
-	err := collection.FindOne(context, f).Decode(&w)
+	err := collection.FindOne(context, bson.D{{Key: "owner._id", Value: owner}}).Decode(&w)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			w = wallet.NewWallet()
@@ -274,14 +228,6 @@ func findWallet(context context.Context, db *DB, owner string) (*wallet.Wallet,
 	return w, nil
 }
 
-func claimFromContext(ctx context.Context) (wallet.Claim, error) {
-	_, claim, err := jwtauth.FromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return claim, nil
-}
-
 func create(ctx context.Context, db *DB) (*wallet.Wallet, error) {
 	user := wallet.UserFromContext(ctx)
 	if user == nil {
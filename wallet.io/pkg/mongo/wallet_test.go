@@ -3,11 +3,14 @@ package mongo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-chi/jwtauth"
 	"github.com/google/go-cmp/cmp"
 	"github.com/lestrrat-go/jwx/jwt"
+	"go.mongodb.org/mongo-driver/bson"
 
 	"wallet.io/pkg/wallet"
 )
@@ -144,6 +147,8 @@ func TestWalletServiceTransfer(t *testing.T) {
 	db := NewTestDB()
 	defer func() {
 		db.Collection(WalletCollection).Drop(ctx1)
+		db.Collection(JournalCollection).Drop(ctx1)
+		db.Collection(TransferCollection).Drop(ctx1)
 		db.client.Disconnect(ctx1)
 	}()
 	s := NewWalletService(db)
@@ -166,38 +171,39 @@ func TestWalletServiceTransfer(t *testing.T) {
 		to       string
 		amount   int64
 		currency string
-		want     *wallet.TransferEvent
+		want     *wallet.Transfer
 		wantErr  bool
 	}{
-		{receipt.ID, 100, "CUP", &wallet.TransferEvent{
+		{receipt.ID, 100, "CUP", &wallet.Transfer{
 			From:     sender.ID,
 			To:       receipt.ID,
-			Type:     wallet.TransferTypeTransfer,
 			Amount:   100,
 			Currency: "CUP",
 			Status:   wallet.TransferStatusPending,
 		}, false},
-		{receipt.ID, 200, "CUP", &wallet.TransferEvent{
+		{receipt.ID, 200, "CUP", &wallet.Transfer{
 			From:     sender.ID,
 			To:       receipt.ID,
-			Type:     wallet.TransferTypeTransfer,
 			Amount:   200,
 			Currency: "CUP",
 			Status:   wallet.TransferStatusPending,
 		}, false},
 		{receipt.ID, 400, "CUP", nil, true},
+		{sender.ID, 100, "CUP", nil, true},
 	}
 
 	for _, tt := range test {
-		event, err := s.Transfer(ctx1, tt.to, tt.amount, tt.currency)
+		transfer, err := s.Transfer(ctx1, tt.to, tt.amount, tt.currency)
 		if err != nil && !tt.wantErr {
 			t.Fatalf("expected no error, got %v, want: %v", err, tt.wantErr)
 		}
 		if tt.want != nil {
-			tt.want.ID = event.ID
-			tt.want.CreatedAt = event.CreatedAt
+			tt.want.ID = transfer.ID
+			tt.want.CreatedAt = transfer.CreatedAt
+			tt.want.UpdatedAt = transfer.UpdatedAt
+			tt.want.ExpiresAt = transfer.ExpiresAt
 		}
-		if diff := cmp.Diff(event, tt.want); diff != "" {
+		if diff := cmp.Diff(transfer, tt.want); diff != "" {
 			t.Fatalf("WalletService.Transfer() mismatch (-want +got):\n%s", diff)
 		}
 	}
@@ -229,51 +235,192 @@ func TestWalletServiceBalance(t *testing.T) {
 }
 
 func TestWalletServiceConfirmTransfer(t *testing.T) {
-	ctx := context.Background()
-	// TODO: add claims on context
+	ctx1 := prepateContext(t)
+	ctx2 := prepateContext(t)
 	db := NewTestDB()
 	defer func() {
-		db.Collection(WalletCollection).Drop(ctx)
-		db.client.Disconnect(ctx)
+		db.Collection(WalletCollection).Drop(ctx1)
+		db.Collection(JournalCollection).Drop(ctx1)
+		db.Collection(TransferCollection).Drop(ctx1)
+		db.client.Disconnect(ctx1)
 	}()
 	s := NewWalletService(db)
 
-	_, err := s.Create(ctx)
+	for _, ctx := range []context.Context{ctx1, ctx2} {
+		if _, err := s.Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sender := wallet.UserFromContext(ctx1)
+	recipient := wallet.UserFromContext(ctx2)
+	if err := s.Deposit(prepateContext(t, wallet.RoleAdmin), sender.ID, 200, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetPin(ctx1, "", "1234"); err != nil {
+		t.Fatal(err)
+	}
+	// the recipient is found by its referral code
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "owner.referral", Value: "REF123"}}}}
+	if _, err := db.Collection(WalletCollection).UpdateOne(ctx1, bson.D{{Key: "owner._id", Value: recipient.ID}}, update); err != nil {
+		t.Fatal(err)
+	}
+
+	transfer, err := s.Transfer(ctx1, "REF123", 150, "CUP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transfer.To != recipient.ID {
+		t.Fatalf("WalletService.Transfer() to %v, want %v", transfer.To, recipient.ID)
+	}
+	if _, err := s.ConfirmTransfer(ctx2, transfer.ID, "1234"); !errors.Is(err, wallet.ErrAccessDenied) {
+		t.Fatalf("WalletService.ConfirmTransfer() by the recipient error = %v", err)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, transfer.ID, "0000"); !errors.Is(err, wallet.ErrInvalidInput) {
+		t.Fatalf("WalletService.ConfirmTransfer() with a wrong pin error = %v", err)
+	}
+	confirmed, err := s.ConfirmTransfer(ctx1, transfer.ID, "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confirmed.Status != wallet.TransferStatusConfirmed || confirmed.Entry == "" {
+		t.Fatalf("WalletService.ConfirmTransfer() = %+v", confirmed)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, transfer.ID, "1234"); !errors.Is(err, wallet.ErrConflict) {
+		t.Fatalf("WalletService.ConfirmTransfer() twice error = %v", err)
+	}
+	if balance, _ := s.Balance(ctx1); balance.Amount["CUP"] != 50 {
+		t.Fatalf("sender balance = %v, want 50", balance.Amount["CUP"])
+	}
+	if balance, _ := s.Balance(ctx2); balance.Amount["CUP"] != 150 {
+		t.Fatalf("recipient balance = %v, want 150", balance.Amount["CUP"])
+	}
+
+	// the balance is checked again on confirmation
+	first, err := s.Transfer(ctx1, recipient.ID, 50, "CUP")
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = s.Create(ctx)
+	second, err := s.Transfer(ctx1, recipient.ID, 50, "CUP")
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, err := s.ConfirmTransfer(ctx1, first.ID, "1234"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, second.ID, "1234"); !errors.Is(err, wallet.ErrInsufficientFunds) {
+		t.Fatalf("WalletService.ConfirmTransfer() over the balance error = %v", err)
+	}
+	if failed, _ := findTransfer(ctx1, db, second.ID); failed.Status != wallet.TransferStatusFailed {
+		t.Fatalf("transfer over the balance = %+v, want failed", failed)
+	}
+}
 
-	s.Deposit(ctx, "test", 200, "CUP")
+func TestWalletServiceConfirmTransferWithoutPin(t *testing.T) {
+	ctx1 := prepateContext(t)
+	ctx2 := prepateContext(t)
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx1)
+		db.Collection(JournalCollection).Drop(ctx1)
+		db.Collection(TransferCollection).Drop(ctx1)
+		db.client.Disconnect(ctx1)
+	}()
+	s := NewWalletService(db)
 
-	var tests = []struct {
-		name     string
-		to       string
-		amount   int64
-		currency string
-		wantErr  bool
-	}{
-		{"ok", "test2", 100, "CUP", false},
-		{"invalid amount", "test2", 400, "CUP", true},
-		{"valid", "test2", 100, "CUP", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			event, err := s.Transfer(ctx, tt.to, tt.amount, tt.currency)
-			if err != nil && !tt.wantErr {
-				t.Fatalf("expected no error, got %v, want: %v", err, tt.wantErr)
-			}
-			if event != nil {
-				err = s.ConfirmTransfer(ctx, event.ID, "1234")
-				if err != nil && !tt.wantErr {
-					t.Fatalf("expected no error, got %v, want: %v", err, tt.wantErr)
-				}
-			}
-		})
+	for _, ctx := range []context.Context{ctx1, ctx2} {
+		if _, err := s.Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sender := wallet.UserFromContext(ctx1)
+	recipient := wallet.UserFromContext(ctx2)
+	if err := s.Deposit(prepateContext(t, wallet.RoleAdmin), sender.ID, 100, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	transfer, err := s.Transfer(ctx1, recipient.ID, 100, "CUP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the checks before the pin is set do not lock the wallet
+	for i := 0; i <= wallet.MaxPinAttempts; i++ {
+		if _, err := s.ConfirmTransfer(ctx1, transfer.ID, "1234"); !errors.Is(err, wallet.ErrInvalidInput) {
+			t.Fatalf("WalletService.ConfirmTransfer() without a pin error = %v", err)
+		}
+	}
+	if err := s.SetPin(ctx1, "", "1234"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, transfer.ID, "1234"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWalletServiceTransferLifecycle(t *testing.T) {
+	ctx1 := prepateContext(t)
+	ctx2 := prepateContext(t)
+	db := NewTestDB()
+	defer func() {
+		db.Collection(WalletCollection).Drop(ctx1)
+		db.Collection(JournalCollection).Drop(ctx1)
+		db.Collection(TransferCollection).Drop(ctx1)
+		db.client.Disconnect(ctx1)
+	}()
+	s := NewWalletService(db)
+
+	for _, ctx := range []context.Context{ctx1, ctx2} {
+		if _, err := s.Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sender := wallet.UserFromContext(ctx1)
+	recipient := wallet.UserFromContext(ctx2)
+	if err := s.Deposit(prepateContext(t, wallet.RoleAdmin), sender.ID, 200, "CUP"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetPin(ctx1, "", "1234"); err != nil {
+		t.Fatal(err)
+	}
+
+	canceled, err := s.Transfer(ctx1, recipient.ID, 100, "CUP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transfer, err := s.CancelTransfer(ctx1, canceled.ID); err != nil || transfer.Status != wallet.TransferStatusCancelled {
+		t.Fatalf("WalletService.CancelTransfer() = %+v, %v", transfer, err)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, canceled.ID, "1234"); !errors.Is(err, wallet.ErrConflict) {
+		t.Fatalf("WalletService.ConfirmTransfer() of a cancelled transfer error = %v", err)
+	}
+
+	expired, err := s.Transfer(ctx1, recipient.ID, 100, "CUP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := expireTransfers(ctx1, db, time.Now().Add(wallet.TransferTTL)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmTransfer(ctx1, expired.ID, "1234"); !errors.Is(err, wallet.ErrConflict) {
+		t.Fatalf("WalletService.ConfirmTransfer() of an expired transfer error = %v", err)
+	}
+	if transfer, _ := findTransfer(ctx1, db, expired.ID); transfer.Status != wallet.TransferStatusFailed || transfer.Reason != wallet.TransferExpired {
+		t.Fatalf("expired transfer = %+v", transfer)
+	}
+
+	// too many wrong pins lock the wallet, even for the right one
+	locked, err := s.Transfer(ctx1, recipient.ID, 100, "CUP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < wallet.MaxPinAttempts; i++ {
+		if _, err := s.ConfirmTransfer(ctx1, locked.ID, "0000"); err == nil {
+			t.Fatal("WalletService.ConfirmTransfer() with a wrong pin succeeded")
+		}
+	}
+	if _, err := s.ConfirmTransfer(ctx1, locked.ID, "1234"); !errors.Is(err, wallet.ErrLocked) {
+		t.Fatalf("WalletService.ConfirmTransfer() of a locked wallet error = %v", err)
+	}
+	if balance, _ := s.Balance(ctx1); balance.Amount["CUP"] != 200 {
+		t.Fatalf("sender balance = %v, want 200", balance.Amount["CUP"])
 	}
 }
 
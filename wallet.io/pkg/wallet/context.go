@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-chi/jwtauth"
 	"github.com/go-chi/oauth"
@@ -18,6 +19,21 @@ type contextKey struct {
 	name string
 }
 
+// Client is a service calling the wallet with a client key.
+type Client struct {
+	Name string `json:"name"`
+}
+
+func NewContextWithClient(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, clientCtxKey, client)
+}
+
+// ClientFromContext finds the client from the context. REQUIRES Middleware to have run.
+func ClientFromContext(ctx context.Context) *Client {
+	raw, _ := ctx.Value(clientCtxKey).(*Client)
+	return raw
+}
+
 func NewContextWithJWT(ctx context.Context, jwt string) context.Context {
 	return context.WithValue(ctx, jwtCtxKey, jwt)
 }
@@ -56,17 +72,40 @@ func UserFromContext(ctx context.Context) *User {
 	if !ok {
 		return nil
 	}
+	// the user is a JSON string in the tokens stored by auth.io
+	var fields map[string]interface{}
+	switch v := userData.(type) {
+	case map[string]interface{}:
+		fields = v
+	case string:
+		if err := json.Unmarshal([]byte(v), &fields); err != nil {
+			return nil
+		}
+	case []byte:
+		if err := json.Unmarshal(v, &fields); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
 	var user User
-	for key, v := range userData.(map[string]interface{}) {
+	for key, v := range fields {
 		switch key {
 		case "id":
-			user.ID = v.(string)
+			user.ID, _ = v.(string)
 		case "email":
-			user.Email = v.(string)
+			user.Email, _ = v.(string)
 		case "name":
-			user.Name = v.(string)
+			user.Name, _ = v.(string)
 		case "role":
-			user.Role = Role(v.(string))
+			role, _ := v.(string)
+			user.Role = Role(role)
+		case "referal":
+			user.Referral, _ = v.(string)
+		case "profile":
+			if profile, ok := v.(map[string]interface{}); ok {
+				user.Phone, _ = profile["phone"].(string)
+			}
 		}
 	}
 	return &user
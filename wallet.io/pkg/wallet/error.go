@@ -19,6 +19,7 @@ var (
 	ErrInvalidCurrency   = errors.New("invalid currency")
 	ErrUnauthorized      = errors.New("unauthorized")
 	ErrBadRequest        = errors.New("bad request")
+	ErrLocked            = errors.New("locked")
 
 	ErrInvalid    = errors.New("invalid argument")           // validation failed
 	ErrPermission = errors.New("permission denied")          // permission error action cannot be perform.
@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"context"
+)
+
+type HoldStatus string
+
+const (
+	HoldStatusHeld     HoldStatus = "HELD"
+	HoldStatusCaptured HoldStatus = "CAPTURED"
+	HoldStatusReleased HoldStatus = "RELEASED"
+)
+
+// Hold reserves an amount of a wallet for a payment captured later, such as
+// the price of a ride. The held amount is taken off the balance until the
+// hold is captured or released.
+type Hold struct {
+	// ID is the client and the idempotency key of the hold, so retried
+	// requests of a client find the same hold.
+	ID     string `json:"id" bson:"_id"`
+	Key    string `json:"key" bson:"key"`
+	Client string `json:"client" bson:"client"`
+	Owner  string `json:"owner" bson:"owner"`
+	// Reference is what the hold pays, such as an order.
+	Reference string     `json:"reference,omitempty" bson:"reference,omitempty"`
+	Amount    int64      `json:"amount" bson:"amount"`
+	Captured  int64      `json:"captured,omitempty" bson:"captured,omitempty"`
+	Currency  string     `json:"currency" bson:"currency"`
+	Status    HoldStatus `json:"status" bson:"status"`
+	CreatedAt uint       `json:"created_at" bson:"created_at"`
+	UpdatedAt uint       `json:"updated_at" bson:"updated_at"`
+}
+
+func HoldID(client, key string) string {
+	return client + ":" + key
+}
+
+type HoldRequest struct {
+	// Key is the idempotency key of the hold.
+	Key       string `json:"-"`
+	Owner     string `json:"owner"`
+	Reference string `json:"reference,omitempty"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+func (r HoldRequest) Validate() error {
+	if r.Key == "" {
+		return NewMissingParameter("idempotency_key")
+	}
+	if r.Owner == "" {
+		return NewMissingParameter("owner")
+	}
+	if r.Amount <= 0 {
+		return NewInvalidParameter("amount", r.Amount)
+	}
+	if r.Currency == "" {
+		return NewMissingParameter("currency")
+	}
+	return nil
+}
+
+// CaptureRequest is the final amount of a hold. The owner and currency are
+// used to pay without a hold when the key has none.
+type CaptureRequest struct {
+	Owner     string `json:"owner"`
+	Reference string `json:"reference,omitempty"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+func (r CaptureRequest) Validate() error {
+	if r.Amount < 0 {
+		return NewInvalidParameter("amount", r.Amount)
+	}
+	return nil
+}
+
+// Check checks the capture matches the hold.
+func (r CaptureRequest) Check(h *Hold) error {
+	if r.Owner != "" && r.Owner != h.Owner {
+		return NewInvalidParameter("owner", r.Owner)
+	}
+	if r.Currency != "" && r.Currency != h.Currency {
+		return NewInvalidParameter("currency", r.Currency)
+	}
+	return nil
+}
+
+// HoldService is the API of the wallet for the other services. It is only
+// available to the clients.
+type HoldService interface {
+	// Hold reserves the amount of the wallet of the owner.
+	Hold(context.Context, HoldRequest) (*Hold, error)
+	// Capture pays the final amount of the hold of the key, which may
+	// differ from the held one. Without a hold the amount is paid from the
+	// balance.
+	Capture(context.Context, string, CaptureRequest) (*Hold, error)
+	// Release gives back the held amount of the hold of the key.
+	Release(context.Context, string) (*Hold, error)
+}
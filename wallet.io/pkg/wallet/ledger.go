@@ -0,0 +1,165 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// The accounts of the platform. The wallets of the users are the accounts
+// returned by WalletAccount.
+const (
+	// AccountRevenue receives the commissions of the platform.
+	AccountRevenue = "platform:revenue"
+	// AccountPayouts clears the withdrawals until they are paid out of the
+	// platform.
+	AccountPayouts = "platform:payouts"
+	// AccountDeposits clears the deposits received by the platform.
+	AccountDeposits = "platform:deposits"
+	// AccountHolds keeps the held amounts until they are captured or
+	// released.
+	AccountHolds = "platform:holds"
+	// AccountOpening balances the wallets opened before the ledger.
+	AccountOpening = "platform:opening"
+	// AccountCharges pays the part of the fares the clients did not capture
+	// from the wallets, such as the cards charged and the coupon discounts.
+	AccountCharges = "platform:charges"
+)
+
+const walletAccountPrefix = "wallet:"
+
+// WalletAccount returns the account of the wallet of the owner.
+func WalletAccount(owner string) string {
+	return walletAccountPrefix + owner
+}
+
+// WalletOwner returns the owner of the wallet account, false when the
+// account is not a wallet.
+func WalletOwner(account string) (string, bool) {
+	return strings.CutPrefix(account, walletAccountPrefix)
+}
+
+// ClearingAccount returns the account clearing the payments of the client
+// until they are settled, such as the rides paid to order.io.
+func ClearingAccount(client string) string {
+	return "clearing:" + client
+}
+
+// Posting moves an amount in or out of an account. Positive amounts credit
+// the account and negative ones debit it.
+type Posting struct {
+	Account string `json:"account" bson:"account"`
+	Amount  int64  `json:"amount" bson:"amount"`
+}
+
+// Entry is an immutable journal entry of the ledger. Its postings add up to
+// zero, so what an account gets is taken from the others.
+type Entry struct {
+	ID string `json:"id" bson:"_id"`
+	// Key identifies the request of the entry, so a retried request is
+	// posted once. It is empty for the entries guarded otherwise.
+	Key       string       `json:"key,omitempty" bson:"key,omitempty"`
+	Type      TransferType `json:"type" bson:"type"`
+	From      string       `json:"from,omitempty" bson:"from,omitempty"`
+	To        string       `json:"to,omitempty" bson:"to,omitempty"`
+	Reference string       `json:"reference,omitempty" bson:"reference,omitempty"`
+	Currency  string       `json:"currency" bson:"currency"`
+	Postings  []Posting    `json:"postings" bson:"postings"`
+	// Overdraft lets the postings take the wallets below zero, such as the
+	// drivers owing the cash they collected.
+	Overdraft bool      `json:"overdraft,omitempty" bson:"overdraft,omitempty"`
+	Earnings  *Earnings `json:"earnings,omitempty" bson:"earnings,omitempty"`
+	CreatedAt uint      `json:"created_at" bson:"created_at"`
+}
+
+// NewEntry returns an entry of the postings. Postings of zero are left out.
+func NewEntry(typ TransferType, currency string, postings ...Posting) *Entry {
+	e := &Entry{
+		ID:        NewID().String(),
+		Type:      typ,
+		Currency:  currency,
+		CreatedAt: uint(Now().UTC().Unix()),
+	}
+	for _, p := range postings {
+		if p.Amount != 0 {
+			e.Postings = append(e.Postings, p)
+		}
+	}
+	return e
+}
+
+func (e *Entry) Validate() error {
+	if e.Currency == "" {
+		return NewMissingParameter("currency")
+	}
+	if len(e.Postings) < 2 {
+		return fmt.Errorf("entry %s moves nothing: %w", e.ID, ErrInvalid)
+	}
+	var sum int64
+	for _, p := range e.Postings {
+		if p.Account == "" {
+			return NewMissingParameter("account")
+		}
+		sum += p.Amount
+	}
+	if sum != 0 {
+		return fmt.Errorf("entry %s is not balanced by %d: %w", e.ID, sum, ErrInvalid)
+	}
+	return nil
+}
+
+// Amount returns the change of the balance of the account.
+func (e *Entry) Amount(account string) int64 {
+	var amount int64
+	for _, p := range e.Postings {
+		if p.Account == account {
+			amount += p.Amount
+		}
+	}
+	return amount
+}
+
+// Transaction returns the entry as a transaction of the account.
+func (e *Entry) Transaction(account string) TransferEvent {
+	return TransferEvent{
+		ID:        e.ID,
+		From:      e.From,
+		To:        e.To,
+		Type:      e.Type,
+		Status:    TransferStatusConfirmed,
+		Amount:    e.Amount(account),
+		Currency:  e.Currency,
+		CreatedAt: e.CreatedAt,
+		Earnings:  e.Earnings,
+	}
+}
+
+// TransactionFilter pages the transactions of a wallet, newest first.
+type TransactionFilter struct {
+	Token string
+	Limit int
+}
+
+// Drift is a cached balance of a wallet differing from its ledger balance.
+type Drift struct {
+	Currency string `json:"currency"`
+	Cached   int64  `json:"cached"`
+	Ledger   int64  `json:"ledger"`
+}
+
+// Reconciliation is the result of checking the cached balances of a wallet
+// against the ledger. The drifted balances are set to the ledger ones.
+type Reconciliation struct {
+	Account string  `json:"account"`
+	Drifts  []Drift `json:"drifts,omitempty"`
+}
+
+// LedgerService is the API of the ledger for the admins.
+type LedgerService interface {
+	// Balance returns the balance of any account in the currency, derived
+	// from the journal.
+	Balance(ctx context.Context, account, currency string) (int64, error)
+	// Reconcile checks the cached balances of the wallet of the owner
+	// against the journal and fixes the drifted ones.
+	Reconcile(ctx context.Context, owner string) (*Reconciliation, error)
+}
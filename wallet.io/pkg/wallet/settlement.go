@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"context"
+)
+
+// Earnings is the split of the fare of a trip between the platform and the
+// driver.
+type Earnings struct {
+	// Reference is the trip, such as an order.
+	Reference  string `json:"reference,omitempty" bson:"reference,omitempty"`
+	Fare       int64  `json:"fare" bson:"fare"`
+	Commission int64  `json:"commission" bson:"commission"`
+	Earnings   int64  `json:"earnings" bson:"earnings"`
+	// Collected is the fare the driver collected in cash, which the driver
+	// owes to the platform.
+	Collected int64 `json:"collected,omitempty" bson:"collected,omitempty"`
+}
+
+// Net is the change of the balance of the driver, negative when the driver
+// owes more than the earnings.
+func (e Earnings) Net() int64 {
+	return e.Earnings - e.Collected
+}
+
+func SettlementID(client, key string) string {
+	return client + ":" + key
+}
+
+// SettlementRequest credits the earnings of a trip to the wallet of the
+// driver.
+type SettlementRequest struct {
+	// Key is the idempotency key of the settlement.
+	Key        string `json:"-"`
+	Owner      string `json:"owner"`
+	Reference  string `json:"reference,omitempty"`
+	Fare       int64  `json:"fare"`
+	Commission int64  `json:"commission"`
+	Earnings   int64  `json:"earnings"`
+	Collected  int64  `json:"collected,omitempty"`
+	// Captured is the fare captured from the wallet of the rider into the
+	// clearing account of the client.
+	Captured int64  `json:"captured,omitempty"`
+	Currency string `json:"currency"`
+}
+
+func (r SettlementRequest) Validate() error {
+	if r.Key == "" {
+		return NewMissingParameter("idempotency_key")
+	}
+	if r.Owner == "" {
+		return NewMissingParameter("owner")
+	}
+	if r.Fare < 0 {
+		return NewInvalidParameter("fare", r.Fare)
+	}
+	if r.Commission < 0 || r.Commission > r.Fare {
+		return NewInvalidParameter("commission", r.Commission)
+	}
+	if r.Earnings != r.Fare-r.Commission {
+		return NewInvalidParameter("earnings", r.Earnings)
+	}
+	if r.Collected < 0 {
+		return NewInvalidParameter("collected", r.Collected)
+	}
+	if r.Captured < 0 || r.Captured+r.Collected > r.Fare {
+		return NewInvalidParameter("captured", r.Captured)
+	}
+	if r.Currency == "" {
+		return NewMissingParameter("currency")
+	}
+	return nil
+}
+
+// Split returns the earnings line of the settlement.
+func (r SettlementRequest) Split() *Earnings {
+	return &Earnings{
+		Reference:  r.Reference,
+		Fare:       r.Fare,
+		Commission: r.Commission,
+		Earnings:   r.Earnings,
+		Collected:  r.Collected,
+	}
+}
+
+// SettlementService settles the earnings of the drivers for the other
+// services. It is only available to the clients.
+type SettlementService interface {
+	// Settle adds the net earnings of the trip to the balance of the
+	// driver, which goes negative when the driver owes the cash collected.
+	// A settlement is applied once per key.
+	Settle(context.Context, SettlementRequest) (*TransferEvent, error)
+}
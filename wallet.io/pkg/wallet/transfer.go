@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+)
+
+// TransferTTL is how long a transfer waits for the confirmation of the
+// sender before it expires.
+const TransferTTL = 15 * time.Minute
+
+// TransferExpired is the reason of the transfers not confirmed in time.
+const TransferExpired = "expired"
+
+// Transfer moves an amount from the wallet of the sender to the one of the
+// recipient once the sender confirms it with the PIN. Nothing moves while it
+// is pending.
+type Transfer struct {
+	ID       string         `json:"id" bson:"_id"`
+	From     string         `json:"from" bson:"from"`
+	To       string         `json:"to" bson:"to"`
+	Amount   int64          `json:"amount" bson:"amount"`
+	Currency string         `json:"currency" bson:"currency"`
+	Status   TransferStatus `json:"status" bson:"status"`
+	// Reason is why the transfer failed.
+	Reason string `json:"reason,omitempty" bson:"reason,omitempty"`
+	// Entry is the journal entry of the confirmed transfer.
+	Entry     string `json:"entry,omitempty" bson:"entry,omitempty"`
+	CreatedAt uint   `json:"created_at" bson:"created_at"`
+	UpdatedAt uint   `json:"updated_at" bson:"updated_at"`
+	ExpiresAt uint   `json:"expires_at" bson:"expires_at"`
+}
+
+func NewTransfer(from, to string, amount int64, currency string) *Transfer {
+	now := Now().UTC()
+	return &Transfer{
+		ID:        NewID().String(),
+		From:      from,
+		To:        to,
+		Amount:    amount,
+		Currency:  currency,
+		Status:    TransferStatusPending,
+		CreatedAt: uint(now.Unix()),
+		UpdatedAt: uint(now.Unix()),
+		ExpiresAt: uint(now.Add(TransferTTL).Unix()),
+	}
+}
+
+func (t *Transfer) Validate() error {
+	if t.To == "" {
+		return NewMissingParameter("to")
+	}
+	if t.From == t.To {
+		return NewInvalidParameter("to", t.To)
+	}
+	if t.Amount <= 0 {
+		return NewInvalidParameter("amount", t.Amount)
+	}
+	if t.Currency == "" {
+		return NewMissingParameter("currency")
+	}
+	return nil
+}
+
+// Expired reports whether the pending transfer was not confirmed in time.
+func (t *Transfer) Expired(now time.Time) bool {
+	return t.Status == TransferStatusPending && uint(now.Unix()) >= t.ExpiresAt
+}
+
+// Transition changes the status of the transfer. Only pending transfers
+// change, to a final status.
+func (t *Transfer) Transition(status TransferStatus, reason string, now time.Time) error {
+	if t.Status != TransferStatusPending {
+		return fmt.Errorf("transfer %s is %s: %w", t.ID, t.Status, ErrConflict)
+	}
+	if status == TransferStatusPending || !status.IsValid() {
+		return NewInvalidParameter("status", status)
+	}
+	t.Status = status
+	t.Reason = reason
+	t.UpdatedAt = uint(now.Unix())
+	return nil
+}
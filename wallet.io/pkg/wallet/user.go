@@ -54,4 +54,7 @@ type User struct {
 	LastName string `json:"last_name" bson:"last_name"`
 	Email    string `json:"email" bson:"email"`
 	Role     Role   `json:"role" bson:"role"`
+	// Phone and Referral are how other users find the wallet of the user.
+	Phone    string `json:"phone,omitempty" bson:"phone,omitempty"`
+	Referral string `json:"referral,omitempty" bson:"referral,omitempty"`
 }
@@ -8,18 +8,30 @@ import (
 )
 
 type Wallet struct {
-	ID               string          `json:"id" bson:"_id"`
-	PIN              []byte          `json:"-" bson:"pin,omitempty"`
-	Owner            User            `json:"owner" bson:"owner"`
-	Balance          Balance         `json:"balance" bson:"balance"`
-	Currency         string          `json:"currency" bson:"currency"`
-	CreatedAt        uint            `json:"-" bson:"created_at"`
-	UpdatedAt        uint            `json:"updated_at" bson:"updated_at"`
-	Events           []interface{}   `json:"-" bson:"events"`
-	TransferEvent    []TransferEvent `json:"-" bson:"transfer_event"`
-	PendingTransfers []TransferEvent `json:"-" bson:"pending_transfers"`
+	ID        string  `json:"id" bson:"_id"`
+	PIN       []byte  `json:"-" bson:"pin,omitempty"`
+	Owner     User    `json:"owner" bson:"owner"`
+	Balance   Balance `json:"balance" bson:"balance"`
+	Currency  string  `json:"currency" bson:"currency"`
+	CreatedAt uint    `json:"-" bson:"created_at"`
+	UpdatedAt uint    `json:"updated_at" bson:"updated_at"`
+	// Ledger reports the balance is backed by the journal. The wallets
+	// opened before it get an opening entry.
+	Ledger bool `json:"-" bson:"ledger"`
+	// PinAttempts counts the failed PIN checks since the last good one and
+	// PinLockedUntil is when the wallet accepts PINs again.
+	PinAttempts    int  `json:"-" bson:"pin_attempts"`
+	PinLockedUntil uint `json:"-" bson:"pin_locked_until"`
 }
 
+const (
+	// MaxPinAttempts is the number of failed PIN checks locking the wallet.
+	MaxPinAttempts = 5
+	// PinLockout is how long a wallet is locked after too many failed PIN
+	// checks.
+	PinLockout = 15 * time.Minute
+)
+
 func (w *Wallet) SetPin(pin string) error {
 	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
 	if err != nil {
@@ -41,74 +53,31 @@ func (w *Wallet) CanTransfer(amount int64, currency string) bool {
 	return w.Balance.Amount[currency]-amount >= 0
 }
 
-func (w *Wallet) FindPendingTransfer(id string) (*TransferEvent, int) {
-	for i, t := range w.PendingTransfers {
-		if t.ID == id {
-			return &t, i
-		}
-	}
-	return nil, 0
-}
-
-func (w *Wallet) Deposit(amount int64, currency string) {
-	w.Balance.Amount[currency] += amount
-	w.UpdatedAt = uint(time.Now().Unix())
-	w.Events = append(w.Events, DepositEvent{
-		Amount:    amount,
-		Currency:  currency,
-		CreatedAt: uint(time.Now().Unix()),
-	})
-	w.TransferEvent = append(w.TransferEvent, TransferEvent{
-		Type:      TransferTypeDeposit,
-		Amount:    amount,
-		Currency:  currency,
-		CreatedAt: uint(time.Now().Unix()),
-	})
-}
-
-func (w *Wallet) Withdraw(amount int64, currency string) {
-	w.Balance.Amount[currency] -= amount
-	w.UpdatedAt = uint(time.Now().Unix())
-	w.Events = append(w.Events, WithdrawEvent{
-		Amount:    amount,
-		Currency:  currency,
-		CreatedAt: uint(time.Now().Unix()),
-	})
-	w.TransferEvent = append(w.TransferEvent, TransferEvent{
-		Type:      TransferTypeWithdraw,
-		Amount:    amount,
-		Currency:  currency,
-		CreatedAt: uint(time.Now().Unix()),
-	})
+// PinLocked reports whether the wallet refuses the PIN checks.
+func (w *Wallet) PinLocked(now time.Time) bool {
+	return uint(now.Unix()) < w.PinLockedUntil
 }
 
 func NewWallet() *Wallet {
 	return &Wallet{
 		ID:        NewID().String(),
 		Balance:   Balance{Amount: make(map[string]int64)},
+		Ledger:    true,
 		CreatedAt: uint(time.Now().Unix()),
 		UpdatedAt: uint(time.Now().Unix()),
 	}
 }
 
-type DepositEvent struct {
-	Amount    int64  `json:"amount" bson:"amount"`
-	Currency  string `json:"currency" bson:"currency"`
-	CreatedAt uint   `json:"-" bson:"created_at"`
-}
-
-type WithdrawEvent struct {
-	Amount    int64  `json:"amount" bson:"amount"`
-	Currency  string `json:"currency" bson:"currency"`
-	CreatedAt uint   `json:"-" bson:"created_at"`
-}
-
 type TransferType string
 
 const (
 	TransferTypeDeposit  TransferType = "deposit"
 	TransferTypeWithdraw TransferType = "withdraw"
 	TransferTypeTransfer TransferType = "transfer"
+	TransferTypePayment  TransferType = "payment"
+	TransferTypeEarnings TransferType = "earnings"
+	TransferTypeHold     TransferType = "hold"
+	TransferTypeRelease  TransferType = "release"
 )
 
 type TransferStatus int
@@ -182,6 +151,8 @@ type TransferEvent struct {
 	Amount    int64          `json:"amount" bson:"amount"`
 	Currency  string         `json:"currency" bson:"currency"`
 	CreatedAt uint           `json:"created_at" bson:"created_at"`
+	// Earnings is the split of the fare of the earnings transactions.
+	Earnings *Earnings `json:"earnings,omitempty" bson:"earnings,omitempty"`
 }
 
 type WalletService interface {
@@ -189,9 +160,17 @@ type WalletService interface {
 	SetPin(context.Context, string, string) error
 	Deposit(context.Context, string, int64, string) error
 	Withdraw(context.Context, int64, string) error
-	Transfer(context.Context, string, int64, string) (*TransferEvent, error)
-	ConfirmTransfer(context.Context, string, string) error
+	// Transfer starts a transfer to the recipient, found by its id, phone,
+	// email or referral code.
+	Transfer(context.Context, string, int64, string) (*Transfer, error)
+	// ConfirmTransfer moves the amount of the pending transfer once the PIN
+	// of the sender is checked.
+	ConfirmTransfer(context.Context, string, string) (*Transfer, error)
+	// CancelTransfer cancels the pending transfer of the sender.
+	CancelTransfer(context.Context, string) (*Transfer, error)
 	Wallet(context.Context) (*Wallet, error)
 	Balance(context.Context) (Balance, error)
-	Transactions(context.Context) ([]TransferEvent, error)
+	// Transactions returns a page of the transactions of the wallet, from
+	// the ledger, and the token of the next one.
+	Transactions(context.Context, TransactionFilter) ([]TransferEvent, string, error)
 }